@@ -0,0 +1,95 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// cableTraceEndpoint is a single cable termination as returned by the Netbox
+// trace endpoints. Only the fields shared by every nested termination
+// serializer are decoded.
+type cableTraceEndpoint struct {
+	ID     int64  `json:"id"`
+	URL    string `json:"url"`
+	Name   string `json:"name"`
+	Device *struct {
+		ID          int64  `json:"id"`
+		DisplayName string `json:"display_name"`
+	} `json:"device"`
+}
+
+// Type derives the termination type, e.g. "dcim.interface", from the API URL
+// of the endpoint since the nested serializers do not include it.
+func (e *cableTraceEndpoint) Type() string {
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, part := range parts {
+		if part == "api" && i+2 < len(parts) {
+			model := strings.TrimSuffix(strings.Replace(parts[i+2], "-", "", -1), "s")
+
+			return fmt.Sprintf("%s.%s", parts[i+1], model)
+		}
+	}
+
+	return ""
+}
+
+// cableTraceSegment is one hop of a traced cable path.
+type cableTraceSegment struct {
+	Near  *cableTraceEndpoint
+	Cable *struct {
+		ID    int64  `json:"id"`
+		Label string `json:"label"`
+	}
+	Far *cableTraceEndpoint
+}
+
+// netboxCableTrace follows the cable path starting at the given component,
+// e.g. netboxCableTrace(c, "dcim/power-ports", 10). go-netbox models the
+// trace response as a single component instead of a list of
+// (termination, cable, termination) tuples, so the request is made directly.
+func netboxCableTrace(c *client.NetBox, componentPath string, id int64) ([]cableTraceSegment, error) {
+	var tuples [][3]json.RawMessage
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "trace", "GET", fmt.Sprintf("/%s/{id}/trace/", componentPath), params, &tuples)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]cableTraceSegment, 0, len(tuples))
+
+	for _, tuple := range tuples {
+		var segment cableTraceSegment
+
+		if err := json.Unmarshal(tuple[0], &segment.Near); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(tuple[1], &segment.Cable); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(tuple[2], &segment.Far); err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
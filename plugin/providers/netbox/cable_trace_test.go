@@ -0,0 +1,34 @@
+package netbox
+
+import "testing"
+
+func TestCableTraceEndpointType(t *testing.T) {
+	cases := []struct {
+		url      string
+		expected string
+	}{
+		{"http://netbox.example.com/api/dcim/interfaces/12/", "dcim.interface"},
+		{"https://netbox.example.com/api/dcim/power-ports/3/", "dcim.powerport"},
+		{"https://netbox.example.com/api/dcim/power-outlets/3/", "dcim.poweroutlet"},
+		{"https://netbox.example.com/api/dcim/power-feeds/7/", "dcim.powerfeed"},
+		{"https://netbox.example.com/api/dcim/console-server-ports/5/", "dcim.consoleserverport"},
+		{"https://netbox.example.com/api/dcim/front-ports/1/", "dcim.frontport"},
+		{"https://netbox.example.com/api/circuits/circuit-terminations/9/", "circuits.circuittermination"},
+		{"https://example.com/netbox/api/dcim/rear-ports/4/", "dcim.rearport"},
+		{"/api/dcim/interfaces/12/", "dcim.interface"},
+		{"https://netbox.example.com/api/dcim/", ""},
+		{"https://netbox.example.com/dcim/interfaces/12/", ""},
+		{"", ""},
+		{"%zz", ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			e := &cableTraceEndpoint{URL: tc.url}
+
+			if actual := e.Type(); actual != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// circuitTerminationRecord is a circuit termination as returned by the Netbox
+// API. go-netbox decodes the connected endpoint of a termination into a string
+// map, which fails as soon as the termination is cabled to an interface, so
+// terminations are read and written directly instead.
+type circuitTerminationRecord struct {
+	ID      int64 `json:"id"`
+	Circuit *struct {
+		ID int64 `json:"id"`
+	} `json:"circuit"`
+	TermSide string `json:"term_side"`
+	Site     *struct {
+		ID int64 `json:"id"`
+	} `json:"site"`
+	PortSpeed     *int64 `json:"port_speed"`
+	UpstreamSpeed *int64 `json:"upstream_speed"`
+	XconnectID    string `json:"xconnect_id"`
+	PpInfo        string `json:"pp_info"`
+	Description   string `json:"description"`
+	Cable         *struct {
+		ID int64 `json:"id"`
+	} `json:"cable"`
+	ConnectionStatus *struct {
+		Value bool `json:"value"`
+	} `json:"connection_status"`
+}
+
+// netboxCircuitTerminationRead reads a single circuit termination by ID.
+func netboxCircuitTerminationRead(c *client.NetBox, id int64) (*circuitTerminationRecord, error) {
+	var termination circuitTerminationRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "circuits_circuit-terminations_read", "GET", "/circuits/circuit-terminations/{id}/", params, &termination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &termination, nil
+}
@@ -2,12 +2,14 @@ package netbox
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
 
 	"net/url"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -25,6 +27,16 @@ type Config struct {
 	// The API endpoint. This defaults to http://localhost/api, and can also be
 	// supplied via the NETBOX_ENDPOINT_ADDR environment variable.
 	Endpoint string
+
+	// The PEM encoded private RSA key of the Netbox user, needed to read and
+	// write secrets. It can also be supplied via the NETBOX_PRIVATE_KEY
+	// environment variable.
+	PrivateKey string
+
+	// Path of a file holding the private RSA key, used instead of PrivateKey.
+	// It can also be supplied via the NETBOX_PRIVATE_KEY_FILE environment
+	// variable.
+	PrivateKeyFile string
 }
 
 type ProviderNetboxClient struct {
@@ -35,8 +47,10 @@ type ProviderNetboxClient struct {
 // Client does the heavy lifting of establishing a base Open API client to Netbox.
 func (c *Config) Client() (interface{}, error) {
 	cfg := Config{
-		AppID:    c.AppID,
-		Endpoint: c.Endpoint,
+		AppID:          c.AppID,
+		Endpoint:       c.Endpoint,
+		PrivateKey:     c.PrivateKey,
+		PrivateKeyFile: c.PrivateKeyFile,
 	}
 
 	log.WithFields(
@@ -80,6 +94,51 @@ func (c *Config) Client() (interface{}, error) {
 
 	netboxClient := client.New(runtimeClient, strfmt.Default)
 
+	if cfg.PrivateKeyFile != "" {
+		privateKey, err := ioutil.ReadFile(cfg.PrivateKeyFile)
+
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"file":  cfg.PrivateKeyFile,
+					"error": err,
+				},
+			).Error("Failed to read private key file")
+
+			return nil, err
+		}
+
+		cfg.PrivateKey = string(privateKey)
+	}
+
+	// Secrets are encrypted with a session key, which Netbox hands out in
+	// exchange for the private key of the user and expects in the
+	// X-Session-Key header of every request that reads or writes secrets.
+	if cfg.PrivateKey != "" {
+		log.Debug("Requesting Netbox session key")
+
+		sessionKey, err := netboxSessionKey(netboxClient, cfg.PrivateKey)
+
+		if err != nil {
+			log.WithFields(
+				log.Fields{
+					"error": err,
+				},
+			).Error("Failed to get Netbox session key")
+
+			return nil, err
+		}
+
+		tokenAuth := runtimeClient.DefaultAuthentication
+		runtimeClient.DefaultAuthentication = runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := tokenAuth.AuthenticateRequest(r, reg); err != nil {
+				return err
+			}
+
+			return r.SetHeaderParam("X-Session-Key", sessionKey)
+		})
+	}
+
 	terraformNetboxClient := ProviderNetboxClient{
 		client:        netboxClient,
 		configuration: cfg,
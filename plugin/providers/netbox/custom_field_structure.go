@@ -1,7 +1,9 @@
 package netbox
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -22,3 +24,73 @@ func customFieldFilterSchema(conflicts []string) *schema.Schema {
 		},
 	}
 }
+
+// flattenCustomFields converts the custom field values of an object into
+// strings. Unset fields are empty and selection fields hold the label of the
+// selected choice.
+func flattenCustomFields(fields map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(fields))
+	for name, value := range fields {
+		switch v := value.(type) {
+		case nil:
+			out[name] = ""
+		case float64:
+			out[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case map[string]interface{}:
+			out[name] = fmt.Sprintf("%v", v["label"])
+		default:
+			out[name] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return out
+}
+
+// expandCustomFields builds the custom field values to send for the
+// "custom_fields" map of a resource. Fields removed from the map are sent as
+// null to clear them, and boolean values are converted as Netbox rejects
+// booleans given as strings.
+func expandCustomFields(d *schema.ResourceData) map[string]interface{} {
+	o, n := d.GetChange("custom_fields")
+
+	out := make(map[string]interface{})
+	for name := range o.(map[string]interface{}) {
+		out[name] = nil
+	}
+
+	for name, value := range n.(map[string]interface{}) {
+		switch value.(string) {
+		case "":
+			out[name] = nil
+		case "true", "false":
+			out[name] = value.(string) == "true"
+		default:
+			out[name] = value
+		}
+	}
+
+	return out
+}
+
+// flattenManagedCustomFields returns the values of the custom fields in the
+// "custom_fields" map of a resource. Selection fields hold the ID of the
+// selected choice, which is also what Netbox expects when setting them.
+func flattenManagedCustomFields(d *schema.ResourceData, fields map[string]interface{}) map[string]string {
+	out := make(map[string]string)
+	for name := range d.Get("custom_fields").(map[string]interface{}) {
+		switch v := fields[name].(type) {
+		case nil:
+			out[name] = ""
+		case bool:
+			out[name] = strconv.FormatBool(v)
+		case float64:
+			out[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case map[string]interface{}:
+			out[name] = fmt.Sprintf("%v", v["value"])
+		default:
+			out[name] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return out
+}
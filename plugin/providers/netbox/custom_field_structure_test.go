@@ -0,0 +1,181 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func testCustomFieldsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"custom_fields": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// testCustomFieldsData returns the resource data for changing the custom
+// fields from those in state to those in config.
+func testCustomFieldsData(t *testing.T, state map[string]string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	sm := schema.InternalMap(testCustomFieldsSchema())
+
+	var s *terraform.InstanceState
+	if state != nil {
+		s = &terraform.InstanceState{ID: "1", Attributes: state}
+	}
+
+	diff, err := sm.Diff(s, terraform.NewResourceConfigRaw(map[string]interface{}{"custom_fields": config}), nil, nil, true)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	d, err := sm.Data(s, diff)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return d
+}
+
+func TestExpandCustomFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		state    map[string]string
+		config   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "new fields",
+			config:   map[string]interface{}{"site_code": "ams1", "rack_count": "12"},
+			expected: map[string]interface{}{"site_code": "ams1", "rack_count": "12"},
+		},
+		{
+			name:     "booleans",
+			config:   map[string]interface{}{"monitored": "true", "billable": "false"},
+			expected: map[string]interface{}{"monitored": true, "billable": false},
+		},
+		{
+			name:     "empty value",
+			config:   map[string]interface{}{"site_code": ""},
+			expected: map[string]interface{}{"site_code": nil},
+		},
+		{
+			name: "removed field",
+			state: map[string]string{
+				"custom_fields.%":         "2",
+				"custom_fields.site_code": "ams1",
+				"custom_fields.monitored": "true",
+			},
+			config:   map[string]interface{}{"site_code": "ams2"},
+			expected: map[string]interface{}{"site_code": "ams2", "monitored": nil},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := testCustomFieldsData(t, tc.state, tc.config)
+
+			if actual := expandCustomFields(d); !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenManagedCustomFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		fields   map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name:     "text",
+			config:   map[string]interface{}{"site_code": "ams1"},
+			fields:   map[string]interface{}{"site_code": "ams1"},
+			expected: map[string]string{"site_code": "ams1"},
+		},
+		{
+			name:     "unset",
+			config:   map[string]interface{}{"site_code": "ams1"},
+			fields:   map[string]interface{}{"site_code": nil},
+			expected: map[string]string{"site_code": ""},
+		},
+		{
+			name:     "boolean",
+			config:   map[string]interface{}{"monitored": "false"},
+			fields:   map[string]interface{}{"monitored": false},
+			expected: map[string]string{"monitored": "false"},
+		},
+		{
+			name:     "large integer",
+			config:   map[string]interface{}{"rack_count": "1000000"},
+			fields:   map[string]interface{}{"rack_count": float64(1000000)},
+			expected: map[string]string{"rack_count": "1000000"},
+		},
+		{
+			name:     "selection",
+			config:   map[string]interface{}{"tier": "3"},
+			fields:   map[string]interface{}{"tier": map[string]interface{}{"value": float64(3), "label": "Gold"}},
+			expected: map[string]string{"tier": "3"},
+		},
+		{
+			name:     "unmanaged field",
+			config:   map[string]interface{}{"site_code": "ams1"},
+			fields:   map[string]interface{}{"site_code": "ams1", "owner": "noc"},
+			expected: map[string]string{"site_code": "ams1"},
+		},
+		{
+			name:     "missing field",
+			config:   map[string]interface{}{"site_code": "ams1"},
+			fields:   map[string]interface{}{},
+			expected: map[string]string{"site_code": ""},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := testCustomFieldsData(t, nil, tc.config)
+
+			if actual := flattenManagedCustomFields(d, tc.fields); !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenCustomFields(t *testing.T) {
+	fields := map[string]interface{}{
+		"site_code":  "ams1",
+		"owner":      nil,
+		"monitored":  true,
+		"rack_count": float64(1000000),
+		"tier":       map[string]interface{}{"value": float64(3), "label": "Gold"},
+	}
+
+	expected := map[string]string{
+		"site_code":  "ams1",
+		"owner":      "",
+		"monitored":  "true",
+		"rack_count": "1000000",
+		"tier":       "Gold",
+	}
+
+	if actual := flattenCustomFields(fields); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	// Data sources show the label of a selection, while resources keep the
+	// choice ID they send to Netbox so that the value round-trips.
+	d := testCustomFieldsData(t, nil, map[string]interface{}{"tier": "3"})
+
+	if actual := flattenManagedCustomFields(d, fields)["tier"]; actual != "3" {
+		t.Fatalf("expected managed selection %q, got %q", "3", actual)
+	}
+}
@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+func dataSourceNetboxClusterGroup() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxClusterGroupRead,
+		Schema: dataSourceNetboxClusterGroupSchema(),
+	}
+}
+
+func dataSourceNetboxClusterGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"cluster_group_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxClusterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = virtualization.NewVirtualizationClusterGroupsListParams()
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if slug, slugOk := d.GetOk("slug"); slugOk {
+		slugStr := slug.(string)
+		parm.SetSlug(&slugStr)
+	}
+
+	log.Debugf("Executing VirtualizationClusterGroupsList against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterGroupsList(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterGroupsList: %v", err)
+
+		return err
+	}
+
+	if len(out.Payload.Results) != 1 {
+		return fmt.Errorf("Expected exactly one cluster group matching name %q and slug %q, found %d", d.Get("name").(string), d.Get("slug").(string), len(out.Payload.Results))
+	}
+
+	clusterGroup := out.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(clusterGroup.ID, 10))
+	d.Set("cluster_group_id", clusterGroup.ID)
+	d.Set("name", clusterGroup.Name)
+	d.Set("slug", clusterGroup.Slug)
+
+	return nil
+}
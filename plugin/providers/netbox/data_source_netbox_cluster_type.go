@@ -0,0 +1,78 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+func dataSourceNetboxClusterType() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxClusterTypeRead,
+		Schema: dataSourceNetboxClusterTypeSchema(),
+	}
+}
+
+func dataSourceNetboxClusterTypeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"cluster_type_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxClusterTypeRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = virtualization.NewVirtualizationClusterTypesListParams()
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if slug, slugOk := d.GetOk("slug"); slugOk {
+		slugStr := slug.(string)
+		parm.SetSlug(&slugStr)
+	}
+
+	log.Debugf("Executing VirtualizationClusterTypesList against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterTypesList(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterTypesList: %v", err)
+
+		return err
+	}
+
+	if len(out.Payload.Results) != 1 {
+		return fmt.Errorf("Expected exactly one cluster type matching name %q and slug %q, found %d", d.Get("name").(string), d.Get("slug").(string), len(out.Payload.Results))
+	}
+
+	clusterType := out.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(clusterType.ID, 10))
+	d.Set("cluster_type_id", clusterType.ID)
+	d.Set("name", clusterType.Name)
+	d.Set("slug", clusterType.Slug)
+
+	return nil
+}
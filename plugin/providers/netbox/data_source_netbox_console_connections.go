@@ -0,0 +1,182 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func dataSourceNetboxConsoleConnections() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxConsoleConnectionsRead,
+		Schema: dataSourceNetboxConsoleConnectionsSchema(),
+	}
+}
+
+func dataSourceNetboxConsoleConnectionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"site": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"device": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"device_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"connection_status": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"connections": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"console_port_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"device_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"device": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"cable_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"connection_status": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"connected_endpoint_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"connected_endpoint": {
+						Type:     schema.TypeMap,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxConsoleConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = dcim.NewDcimConsoleConnectionsListParams()
+
+	if site, siteOk := d.GetOk("site"); siteOk {
+		siteStr := site.(string)
+		parm.SetSite(&siteStr)
+	}
+
+	if device, deviceOk := d.GetOk("device"); deviceOk {
+		deviceStr := device.(string)
+		parm.SetDevice(&deviceStr)
+	}
+
+	if deviceID, deviceIDOk := d.GetOk("device_id"); deviceIDOk {
+		deviceIDStr := strconv.Itoa(deviceID.(int))
+		parm.SetDeviceID(&deviceIDStr)
+	}
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	// GetOk can not tell an explicit false apart from an unset value, and
+	// Netbox expects the Python spelling of the boolean choice.
+	if connectionStatus, connectionStatusOk := d.GetOkExists("connection_status"); connectionStatusOk {
+		connectionStatusStr := "False"
+		if connectionStatus.(bool) {
+			connectionStatusStr = "True"
+		}
+		parm.SetConnectionStatus(&connectionStatusStr)
+	}
+
+	log.Debugf("Executing DcimConsoleConnectionsList against Netbox: %v", parm)
+
+	ports, err := netboxConsoleConnectionList(netboxClient, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsoleConnectionsList: %v", err)
+
+		return err
+	}
+
+	connections := make([]map[string]interface{}, 0, len(ports))
+
+	for _, port := range ports {
+		connection := map[string]interface{}{
+			"console_port_id":         port.ID,
+			"name":                    port.Name,
+			"connected_endpoint_type": port.ConnectedEndpointType,
+		}
+
+		if endpoint := port.ConnectedEndpoint; endpoint != nil {
+			connectedEndpoint := map[string]interface{}{
+				"id":   strconv.FormatInt(endpoint.ID, 10),
+				"name": endpoint.Name,
+				"url":  endpoint.URL,
+			}
+
+			if endpoint.Device != nil {
+				connectedEndpoint["device_id"] = strconv.FormatInt(endpoint.Device.ID, 10)
+				connectedEndpoint["device"] = endpoint.Device.DisplayName
+			}
+
+			connection["connected_endpoint"] = connectedEndpoint
+		}
+
+		if port.Device != nil {
+			connection["device_id"] = port.Device.ID
+			connection["device"] = port.Device.DisplayName
+		}
+
+		if port.Cable != nil {
+			connection["cable_id"] = port.Cable.ID
+		}
+
+		if port.ConnectionStatus != nil {
+			connection["connection_status"] = port.ConnectionStatus.Value
+		}
+
+		connections = append(connections, connection)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s/%s/%d/%s/%v",
+		d.Get("site").(string),
+		d.Get("device").(string),
+		d.Get("device_id").(int),
+		d.Get("name").(string),
+		d.Get("connection_status"),
+	))))
+	d.Set("connections", connections)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func dataSourceNetboxDevice() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxDeviceRead,
+		Schema: dataSourceNetboxDeviceSchema(),
+	}
+}
+
+func dataSourceNetboxDeviceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"device_id", "name"},
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"device_id", "name"},
+		},
+		"site_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"local_context_data": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		// The config context as rendered by Netbox from all matching config
+		// contexts and the local context data, encoded as JSON.
+		"config_context": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = dcim.NewDcimDevicesListParams()
+
+	if id, idOk := d.GetOk("device_id"); idOk {
+		idStr := strconv.Itoa(id.(int))
+		parm.SetID(&idStr)
+	}
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	log.Debugf("Executing DcimDevicesList against Netbox: %v", parm)
+
+	devices, err := netboxDeviceList(netboxClient, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDevicesList: %v", err)
+
+		return err
+	}
+
+	if len(devices) != 1 {
+		return fmt.Errorf("Expected exactly one device matching the given filters, found %d", len(devices))
+	}
+
+	// As with virtual machines, the config context is only rendered when a
+	// single device is retrieved.
+	device, err := netboxDeviceRead(netboxClient, devices[0].ID)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDevicesRead: %v", err)
+
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(device.ID, 10))
+	d.Set("device_id", device.ID)
+	d.Set("name", device.Name)
+
+	var siteID int64
+	if device.Site != nil {
+		siteID = device.Site.ID
+	}
+	d.Set("site_id", siteID)
+
+	localContextData, err := flattenJSON(device.LocalContextData)
+
+	if err != nil {
+		return err
+	}
+	d.Set("local_context_data", localContextData)
+
+	configContext, err := flattenJSON(device.ConfigContext)
+
+	if err != nil {
+		return err
+	}
+	d.Set("config_context", configContext)
+
+	return nil
+}
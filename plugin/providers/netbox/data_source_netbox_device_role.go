@@ -0,0 +1,93 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func dataSourceNetboxDeviceRole() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxDeviceRoleRead,
+		Schema: dataSourceNetboxDeviceRoleSchema(),
+	}
+}
+
+func dataSourceNetboxDeviceRoleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"device_role_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"color": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vm_role": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxDeviceRoleRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = dcim.NewDcimDeviceRolesListParams()
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if slug, slugOk := d.GetOk("slug"); slugOk {
+		slugStr := slug.(string)
+		parm.SetSlug(&slugStr)
+	}
+
+	log.Debugf("Executing DcimDeviceRolesList against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimDeviceRolesList(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceRolesList: %v", err)
+
+		return err
+	}
+
+	if len(out.Payload.Results) != 1 {
+		return fmt.Errorf("Expected exactly one device role matching name %q and slug %q, found %d", d.Get("name").(string), d.Get("slug").(string), len(out.Payload.Results))
+	}
+
+	role := out.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(role.ID, 10))
+	d.Set("device_role_id", role.ID)
+	d.Set("name", role.Name)
+	d.Set("slug", role.Slug)
+	d.Set("color", role.Color)
+	d.Set("vm_role", role.VMRole)
+	d.Set("description", role.Description)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceNetboxDeviceRead(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dcim/devices/":
+			if got := r.URL.Query().Get("name"); got != "edge1" {
+				t.Errorf("name filter: got %q, want %q", got, "edge1")
+			}
+
+			// Netbox does not render config contexts in lists.
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 7, "name": "edge1", "site": {"id": 3}, "local_context_data": {"ntp": "10.0.0.1"}, "config_context": null}]}`)
+		case "/api/dcim/devices/7/":
+			fmt.Fprint(w, `{"id": 7, "name": "edge1", "site": {"id": 3}, "local_context_data": {"ntp": "10.0.0.1"}, "config_context": {"dns": ["10.0.0.53"], "ntp": "10.0.0.1"}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDeviceSchema(), map[string]interface{}{
+		"name": "edge1",
+	})
+
+	if err := dataSourceNetboxDeviceRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"device_id":          7,
+		"site_id":            3,
+		"local_context_data": `{"ntp":"10.0.0.1"}`,
+		"config_context":     `{"dns":["10.0.0.53"],"ntp":"10.0.0.1"}`,
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+
+	if d.Id() != "7" {
+		t.Errorf("id: got %q, want %q", d.Id(), "7")
+	}
+}
+
+func TestDataSourceNetboxDeviceReadNoMatch(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "results": []}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxDeviceSchema(), map[string]interface{}{
+		"name": "missing",
+	})
+
+	if err := dataSourceNetboxDeviceRead(d, meta); err == nil {
+		t.Fatal("expected an error when no device matches")
+	}
+}
@@ -0,0 +1,103 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+)
+
+// dataSourceNetboxExportTemplate looks up an export template. Netbox 2.7 only
+// renders export templates in the web UI, the API has no endpoint for it, so
+// the template code is returned for rendering outside of Netbox.
+func dataSourceNetboxExportTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxExportTemplateRead,
+		Schema: dataSourceNetboxExportTemplateSchema(),
+	}
+}
+
+func dataSourceNetboxExportTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"content_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(exportTemplateContentTypes, false),
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"export_template_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template_language": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"template_code": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mime_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"file_extension": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxExportTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	contentType := d.Get("content_type").(string)
+	name := d.Get("name").(string)
+
+	var parm = extras.NewExtrasExportTemplatesListParams().
+		WithContentType(&contentType).
+		WithName(&name)
+
+	log.Debugf("Executing ExtrasExportTemplatesList against Netbox: %v", parm)
+
+	out, err := netboxClient.Extras.ExtrasExportTemplatesList(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasExportTemplatesList: %v", err)
+
+		return err
+	}
+
+	if len(out.Payload.Results) != 1 {
+		return fmt.Errorf("Expected exactly one export template for %q named %q, found %d", contentType, name, len(out.Payload.Results))
+	}
+
+	exportTemplate := out.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(exportTemplate.ID, 10))
+	d.Set("export_template_id", exportTemplate.ID)
+	d.Set("description", exportTemplate.Description)
+
+	var templateLanguage string
+	if exportTemplate.TemplateLanguage != nil && exportTemplate.TemplateLanguage.Value != nil {
+		templateLanguage = *exportTemplate.TemplateLanguage.Value
+	}
+	d.Set("template_language", templateLanguage)
+
+	d.Set("template_code", exportTemplate.TemplateCode)
+	d.Set("mime_type", exportTemplate.MimeType)
+	d.Set("file_extension", exportTemplate.FileExtension)
+
+	return nil
+}
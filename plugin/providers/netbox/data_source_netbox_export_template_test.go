@@ -0,0 +1,72 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceNetboxExportTemplateRead(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/extras/export-templates/" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("content_type"); got != "dcim.device" {
+			t.Errorf("content_type filter: got %q, want %q", got, "dcim.device")
+		}
+		if got := query.Get("name"); got != "DNS zone" {
+			t.Errorf("name filter: got %q, want %q", got, "DNS zone")
+		}
+
+		fmt.Fprint(w, `{"count": 1, "results": [{"id": 4, "content_type": "dcim.device", "name": "DNS zone", "description": "Forward zone", "template_language": {"value": "jinja2", "label": "Jinja2", "id": 20}, "template_code": "{% for d in queryset %}{{ d.name }}{% endfor %}", "mime_type": "text/plain", "file_extension": "zone"}]}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxExportTemplateSchema(), map[string]interface{}{
+		"content_type": "dcim.device",
+		"name":         "DNS zone",
+	})
+
+	if err := dataSourceNetboxExportTemplateRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"export_template_id": 4,
+		"description":        "Forward zone",
+		"template_language":  "jinja2",
+		"template_code":      "{% for d in queryset %}{{ d.name }}{% endfor %}",
+		"mime_type":          "text/plain",
+		"file_extension":     "zone",
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+
+	if d.Id() != "4" {
+		t.Errorf("id: got %q, want %q", d.Id(), "4")
+	}
+}
+
+func TestDataSourceNetboxExportTemplateReadNoMatch(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "results": []}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxExportTemplateSchema(), map[string]interface{}{
+		"content_type": "dcim.device",
+		"name":         "missing",
+	})
+
+	if err := dataSourceNetboxExportTemplateRead(d, meta); err == nil {
+		t.Fatal("expected an error when no export template matches")
+	}
+}
@@ -0,0 +1,343 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func dataSourceNetboxFrontPortTrace() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxFrontPortTraceRead,
+		Schema: dataSourceNetboxFrontPortTraceSchema(),
+	}
+}
+
+func dataSourceNetboxFrontPortTraceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"front_port_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		// Endpoint patched into the front port, possibly through further
+		// patch panels, from which the cable path is traced
+		"endpoint_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"endpoint_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"interface_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"far_end_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"far_end_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"far_end_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"far_end_device_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"far_end_device": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"far_end_interface_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"segments": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"near_end_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"near_end_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"near_end_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"cable_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"far_end_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"far_end_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"far_end_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceNetboxFrontPortTraceRead follows the cable path of a patch panel
+// front port to the component at the far end. Netbox only exposes the trace
+// endpoint on endpoint components, so the path is traced from the endpoint
+// patched into the front port, which includes the front port itself.
+func dataSourceNetboxFrontPortTraceRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("front_port_id").(int))
+
+	endpointType, endpointID, err := netboxFrontPortEndpoint(netboxClient, id)
+
+	if err != nil {
+		return err
+	}
+
+	segments, err := netboxCableTrace(netboxClient, netboxCableTraceComponentPaths[endpointType], endpointID)
+
+	if err != nil {
+		log.Debugf("Failed to trace %s %d: %v", endpointType, endpointID, err)
+
+		return err
+	}
+
+	path := make([]map[string]interface{}, 0, len(segments))
+
+	for _, segment := range segments {
+		hop := make(map[string]interface{})
+
+		if segment.Near != nil {
+			hop["near_end_type"] = segment.Near.Type()
+			hop["near_end_id"] = segment.Near.ID
+			hop["near_end_name"] = segment.Near.Name
+		}
+
+		if segment.Cable != nil {
+			hop["cable_id"] = segment.Cable.ID
+		}
+
+		if segment.Far != nil {
+			hop["far_end_type"] = segment.Far.Type()
+			hop["far_end_id"] = segment.Far.ID
+			hop["far_end_name"] = segment.Far.Name
+		}
+
+		path = append(path, hop)
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("endpoint_type", endpointType)
+	d.Set("endpoint_id", endpointID)
+	d.Set("segments", path)
+
+	if endpointType == "dcim.interface" {
+		d.Set("interface_id", endpointID)
+	}
+
+	if len(segments) == 0 || segments[len(segments)-1].Far == nil {
+		log.Debugf("Cable path of front port %d has no far end", id)
+
+		return nil
+	}
+
+	far := segments[len(segments)-1].Far
+
+	d.Set("far_end_type", far.Type())
+	d.Set("far_end_id", far.ID)
+	d.Set("far_end_name", far.Name)
+
+	if far.Device != nil {
+		d.Set("far_end_device_id", far.Device.ID)
+		d.Set("far_end_device", far.Device.DisplayName)
+	}
+
+	if far.Type() == "dcim.interface" {
+		d.Set("far_end_interface_id", far.ID)
+	}
+
+	return nil
+}
+
+// netboxCableTraceComponentPaths maps the termination types that have a trace
+// endpoint in the Netbox API to their API path.
+var netboxCableTraceComponentPaths = map[string]string{
+	"dcim.interface":         "dcim/interfaces",
+	"dcim.consoleport":       "dcim/console-ports",
+	"dcim.consoleserverport": "dcim/console-server-ports",
+	"dcim.powerport":         "dcim/power-ports",
+	"dcim.poweroutlet":       "dcim/power-outlets",
+}
+
+// netboxFrontPortEndpoint returns the type and ID of the endpoint patched into
+// the given front port. When the front port is patched into another patch
+// panel, the path is followed through its front and rear port pairs. A rear
+// port with several positions can not be passed from its rear, as the
+// position the path continues on is unknown.
+func netboxFrontPortEndpoint(c *client.NetBox, id int64) (string, int64, error) {
+	log.Debugf("Executing DcimFrontPortsRead against Netbox")
+
+	front, err := c.Dcim.DcimFrontPortsRead(dcim.NewDcimFrontPortsReadParams().WithID(id), nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimFrontPortsRead against Netbox: %v", err)
+
+		return "", 0, err
+	}
+
+	if front.Payload.Cable == nil {
+		return "", 0, fmt.Errorf("Front port %d is not cabled", id)
+	}
+
+	cableID := front.Payload.Cable.ID
+	nearType, nearID := "dcim.frontport", id
+
+	seen := make(map[string]bool)
+
+	for {
+		cable, err := netboxCableRead(c, cableID)
+
+		if err != nil {
+			log.Debugf("Failed to read cable %d of %s %d: %v", cableID, nearType, nearID, err)
+
+			return "", 0, err
+		}
+
+		peerType, peerID := cable.Peer(nearType, nearID)
+
+		if _, ok := netboxCableTraceComponentPaths[peerType]; ok {
+			return peerType, peerID, nil
+		}
+
+		key := fmt.Sprintf("%s %d", peerType, peerID)
+		if seen[key] {
+			return "", 0, fmt.Errorf("Cable path of front port %d loops at %s", id, key)
+		}
+		seen[key] = true
+
+		var next *models.NestedCable
+
+		switch peerType {
+		case "dcim.frontport":
+			// Pass through the patch panel to the rear port behind it
+			peer, err := c.Dcim.DcimFrontPortsRead(dcim.NewDcimFrontPortsReadParams().WithID(peerID), nil)
+
+			if err != nil {
+				log.Debugf("Failed to execute DcimFrontPortsRead against Netbox: %v", err)
+
+				return "", 0, err
+			}
+
+			if peer.Payload.RearPort == nil {
+				return "", 0, fmt.Errorf("Front port %d has no rear port", peerID)
+			}
+
+			rear, err := c.Dcim.DcimRearPortsRead(dcim.NewDcimRearPortsReadParams().WithID(peer.Payload.RearPort.ID), nil)
+
+			if err != nil {
+				log.Debugf("Failed to execute DcimRearPortsRead against Netbox: %v", err)
+
+				return "", 0, err
+			}
+
+			next = rear.Payload.Cable
+			nearType, nearID = "dcim.rearport", rear.Payload.ID
+		case "dcim.rearport":
+			// Pass through the patch panel to the front port of the rear port
+			peer, err := netboxRearPortFrontPort(c, peerID)
+
+			if err != nil {
+				return "", 0, err
+			}
+
+			next = peer.Cable
+			nearType, nearID = "dcim.frontport", peer.ID
+		default:
+			return "", 0, fmt.Errorf("Front port %d is patched to %s %d, which Netbox can not trace", id, peerType, peerID)
+		}
+
+		if next == nil {
+			return "", 0, fmt.Errorf("Cable path of front port %d ends at %s %d without reaching an endpoint", id, nearType, nearID)
+		}
+
+		cableID = next.ID
+	}
+}
+
+// netboxRearPortFrontPort returns the front port mapped to a single position
+// rear port.
+func netboxRearPortFrontPort(c *client.NetBox, id int64) (*models.FrontPort, error) {
+	rear, err := c.Dcim.DcimRearPortsRead(dcim.NewDcimRearPortsReadParams().WithID(id), nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimRearPortsRead against Netbox: %v", err)
+
+		return nil, err
+	}
+
+	if rear.Payload.Positions > 1 {
+		return nil, fmt.Errorf("Cable path can not be followed through rear port %d, which has %d positions", id, rear.Payload.Positions)
+	}
+
+	if rear.Payload.Device == nil {
+		return nil, fmt.Errorf("Rear port %d has no device", id)
+	}
+
+	deviceIDStr := strconv.FormatInt(rear.Payload.Device.ID, 10)
+
+	var parm = dcim.NewDcimFrontPortsListParams().WithDeviceID(&deviceIDStr)
+
+	fronts := make([]*models.FrontPort, 0)
+
+	for {
+		offset := int64(len(fronts))
+		parm.SetOffset(&offset)
+
+		log.Debugf("Executing DcimFrontPortsList against Netbox: %v", parm)
+
+		out, err := c.Dcim.DcimFrontPortsList(parm, nil)
+
+		if err != nil {
+			log.Debugf("Failed to execute DcimFrontPortsList: %v", err)
+
+			return nil, err
+		}
+
+		fronts = append(fronts, out.Payload.Results...)
+
+		if len(out.Payload.Results) == 0 || int64(len(fronts)) >= *out.Payload.Count {
+			break
+		}
+	}
+
+	for _, front := range fronts {
+		if front.RearPort != nil && front.RearPort.ID == id {
+			return front, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Rear port %d has no front port", id)
+}
@@ -0,0 +1,173 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// testNetboxResponses returns a provider client that answers the given API
+// paths with the given JSON.
+func testNetboxResponses(t *testing.T, responses map[string]string) *ProviderNetboxClient {
+	return testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+
+			return
+		}
+
+		fmt.Fprint(w, body)
+	})
+}
+
+func TestNetboxFrontPortEndpoint(t *testing.T) {
+	cases := []struct {
+		name         string
+		responses    map[string]string
+		expectedType string
+		expectedID   int64
+		expectedErr  string
+	}{
+		{
+			name: "interface",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.interface", "termination_a_id": 5, "termination_b_type": "dcim.frontport", "termination_b_id": 1}`,
+			},
+			expectedType: "dcim.interface",
+			expectedID:   5,
+		},
+		{
+			name: "through front port of another panel",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.frontport", "termination_a_id": 1, "termination_b_type": "dcim.frontport", "termination_b_id": 3}`,
+				"/api/dcim/front-ports/3/": `{"id": 3, "cable": {"id": 100}, "rear_port": {"id": 4}}`,
+				"/api/dcim/rear-ports/4/":  `{"id": 4, "cable": {"id": 101}, "positions": 1}`,
+				"/api/dcim/cables/101/":    `{"id": 101, "termination_a_type": "dcim.rearport", "termination_a_id": 4, "termination_b_type": "dcim.interface", "termination_b_id": 5}`,
+			},
+			expectedType: "dcim.interface",
+			expectedID:   5,
+		},
+		{
+			name: "through rear port of another panel",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.frontport", "termination_a_id": 1, "termination_b_type": "dcim.rearport", "termination_b_id": 6}`,
+				"/api/dcim/rear-ports/6/":  `{"id": 6, "device": {"id": 40}, "cable": {"id": 100}, "positions": 1}`,
+				"/api/dcim/front-ports/":   `{"count": 2, "results": [{"id": 8, "rear_port": {"id": 9}}, {"id": 7, "cable": {"id": 102}, "rear_port": {"id": 6}}]}`,
+				"/api/dcim/cables/102/":    `{"id": 102, "termination_a_type": "dcim.frontport", "termination_a_id": 7, "termination_b_type": "dcim.consoleport", "termination_b_id": 11}`,
+			},
+			expectedType: "dcim.consoleport",
+			expectedID:   11,
+		},
+		{
+			name: "not cabled",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": null, "rear_port": {"id": 2}}`,
+			},
+			expectedErr: "Front port 1 is not cabled",
+		},
+		{
+			name: "rear port with several positions",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.frontport", "termination_a_id": 1, "termination_b_type": "dcim.rearport", "termination_b_id": 6}`,
+				"/api/dcim/rear-ports/6/":  `{"id": 6, "device": {"id": 40}, "cable": {"id": 100}, "positions": 12}`,
+			},
+			expectedErr: "rear port 6, which has 12 positions",
+		},
+		{
+			name: "dead end",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.frontport", "termination_a_id": 1, "termination_b_type": "dcim.frontport", "termination_b_id": 3}`,
+				"/api/dcim/front-ports/3/": `{"id": 3, "cable": {"id": 100}, "rear_port": {"id": 4}}`,
+				"/api/dcim/rear-ports/4/":  `{"id": 4, "cable": null, "positions": 1}`,
+			},
+			expectedErr: "ends at dcim.rearport 4 without reaching an endpoint",
+		},
+		{
+			name: "circuit termination",
+			responses: map[string]string{
+				"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+				"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.frontport", "termination_a_id": 1, "termination_b_type": "circuits.circuittermination", "termination_b_id": 12}`,
+			},
+			expectedErr: "patched to circuits.circuittermination 12, which Netbox can not trace",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := testNetboxResponses(t, tc.responses)
+
+			endpointType, endpointID, err := netboxFrontPortEndpoint(meta.client, 1)
+
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if endpointType != tc.expectedType || endpointID != tc.expectedID {
+				t.Fatalf("expected %s %d, got %s %d", tc.expectedType, tc.expectedID, endpointType, endpointID)
+			}
+		})
+	}
+}
+
+func TestDataSourceNetboxFrontPortTraceRead(t *testing.T) {
+	meta := testNetboxResponses(t, map[string]string{
+		"/api/dcim/front-ports/1/": `{"id": 1, "cable": {"id": 100}, "rear_port": {"id": 2}}`,
+		"/api/dcim/cables/100/":    `{"id": 100, "termination_a_type": "dcim.interface", "termination_a_id": 5, "termination_b_type": "dcim.frontport", "termination_b_id": 1}`,
+		"/api/dcim/interfaces/5/trace/": `[
+			[
+				{"id": 5, "url": "http://netbox/api/dcim/interfaces/5/", "name": "eth0"},
+				{"id": 100},
+				{"id": 1, "url": "http://netbox/api/dcim/front-ports/1/", "name": "1"}
+			],
+			[
+				{"id": 2, "url": "http://netbox/api/dcim/rear-ports/2/", "name": "1"},
+				{"id": 103},
+				{"id": 9, "url": "http://netbox/api/dcim/interfaces/9/", "name": "ge-0/0/1", "device": {"id": 30, "display_name": "core1"}}
+			]
+		]`,
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxFrontPortTraceSchema(), map[string]interface{}{
+		"front_port_id": 1,
+	})
+
+	if err := dataSourceNetboxFrontPortTraceRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"endpoint_type":        "dcim.interface",
+		"endpoint_id":          5,
+		"interface_id":         5,
+		"far_end_type":         "dcim.interface",
+		"far_end_id":           9,
+		"far_end_device":       "core1",
+		"far_end_interface_id": 9,
+		"segments.#":           2,
+		"segments.1.cable_id":  103,
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+}
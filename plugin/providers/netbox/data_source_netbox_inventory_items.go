@@ -0,0 +1,145 @@
+package netbox
+
+import (
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func dataSourceNetboxInventoryItems() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxInventoryItemsRead,
+		Schema: dataSourceNetboxInventoryItemsSchema(),
+	}
+}
+
+func dataSourceNetboxInventoryItemsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"inventory_items": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"inventory_item_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"parent_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"manufacturer_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"manufacturer": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"part_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"serial": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"asset_tag": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"discovered": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"tags": {
+						Type:     schema.TypeSet,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxInventoryItemsRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	deviceID := strconv.Itoa(d.Get("device_id").(int))
+
+	var parm = dcim.NewDcimInventoryItemsListParams().WithDeviceID(&deviceID)
+
+	items := make([]*models.InventoryItem, 0)
+
+	for {
+		offset := int64(len(items))
+		parm.SetOffset(&offset)
+
+		log.Debugf("Executing DcimInventoryItemsList against Netbox: %v", parm)
+
+		out, err := netboxClient.Dcim.DcimInventoryItemsList(parm, nil)
+
+		if err != nil {
+			log.Debugf("Failed to execute DcimInventoryItemsList: %v", err)
+
+			return err
+		}
+
+		items = append(items, out.Payload.Results...)
+
+		if len(out.Payload.Results) == 0 || int64(len(items)) >= *out.Payload.Count {
+			break
+		}
+	}
+
+	inventory := make([]map[string]interface{}, 0, len(items))
+
+	for _, item := range items {
+		entry := map[string]interface{}{
+			"inventory_item_id": item.ID,
+			"name":              *item.Name,
+			"part_id":           item.PartID,
+			"serial":            item.Serial,
+			"discovered":        item.Discovered,
+			"description":       item.Description,
+			"tags":              item.Tags,
+		}
+
+		if item.Parent != nil {
+			entry["parent_id"] = *item.Parent
+		}
+
+		if item.Manufacturer != nil {
+			entry["manufacturer_id"] = item.Manufacturer.ID
+			entry["manufacturer"] = *item.Manufacturer.Name
+		}
+
+		if item.AssetTag != nil {
+			entry["asset_tag"] = *item.AssetTag
+		}
+
+		inventory = append(inventory, entry)
+	}
+
+	d.SetId(deviceID)
+	d.Set("inventory_items", inventory)
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package netbox
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceNetboxObjectChanges() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxObjectChangesRead,
+		Schema: dataSourceNetboxObjectChangesSchema(),
+	}
+}
+
+func dataSourceNetboxObjectChangesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Content type of the changed objects, sent to Netbox as the
+		// changed_object_type query filter
+		"changed_object_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"changed_object_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"user_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"action": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"create",
+				"update",
+				"delete",
+			}, false),
+		},
+		"time_after": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.ValidateRFC3339TimeString,
+		},
+		"time_before": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.ValidateRFC3339TimeString,
+		},
+		"changes": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"object_change_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"user_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"request_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"action": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"changed_object_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"changed_object_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					// The object as it was after the change, encoded as
+					// JSON
+					"object_data": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					// The object as it was before the change, taken from
+					// the previous listed change of the same object. Empty
+					// for creations and when that change is filtered out.
+					"prechange_data": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxObjectChangesRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	filters := make(map[string]string)
+
+	if changedObjectType, changedObjectTypeOk := d.GetOk("changed_object_type"); changedObjectTypeOk {
+		filters["changed_object_type"] = changedObjectType.(string)
+	}
+
+	if changedObjectID, changedObjectIDOk := d.GetOk("changed_object_id"); changedObjectIDOk {
+		filters["changed_object_id"] = strconv.Itoa(changedObjectID.(int))
+	}
+
+	if userName, userNameOk := d.GetOk("user_name"); userNameOk {
+		filters["user_name"] = userName.(string)
+	}
+
+	if action, actionOk := d.GetOk("action"); actionOk {
+		filters["action"] = action.(string)
+	}
+
+	if timeAfter, timeAfterOk := d.GetOk("time_after"); timeAfterOk {
+		filters["time_after"] = timeAfter.(string)
+	}
+
+	if timeBefore, timeBeforeOk := d.GetOk("time_before"); timeBeforeOk {
+		filters["time_before"] = timeBefore.(string)
+	}
+
+	log.Debugf("Executing ExtrasObjectChangesList against Netbox: %v", filters)
+
+	changes, err := netboxObjectChangeList(netboxClient, filters)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasObjectChangesList: %v", err)
+
+		return err
+	}
+
+	changeList := make([]map[string]interface{}, len(changes))
+
+	for i, change := range changes {
+		objectData, err := flattenJSON(change.ObjectData)
+
+		if err != nil {
+			return err
+		}
+
+		entry := map[string]interface{}{
+			"object_change_id":    change.ID,
+			"time":                change.Time,
+			"user_name":           change.UserName,
+			"request_id":          change.RequestID,
+			"changed_object_type": change.ChangedObjectType,
+			"changed_object_id":   change.ChangedObjectID,
+			"object_data":         objectData,
+		}
+
+		if change.Action != nil {
+			entry["action"] = change.Action.Value
+		}
+
+		changeList[i] = entry
+	}
+
+	// Netbox 2.7 does not record the state of an object before a change.
+	// The changes are listed newest first, so walk them oldest first and
+	// carry over the object data of the last change of each object.
+	lastObjectData := make(map[string]string)
+	for i := len(changes) - 1; i >= 0; i-- {
+		key := fmt.Sprintf("%s/%d", changes[i].ChangedObjectType, changes[i].ChangedObjectID)
+
+		changeList[i]["prechange_data"] = lastObjectData[key]
+		lastObjectData[key] = changeList[i]["object_data"].(string)
+	}
+
+	idParts := make([]string, 0, len(filters))
+	for key, value := range filters {
+		idParts = append(idParts, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(idParts)
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(idParts, "&"))))
+	d.Set("changes", changeList)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceNetboxObjectChangesRead(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/extras/object-changes/" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		if got := query.Get("changed_object_type"); got != "dcim.device" {
+			t.Errorf("changed_object_type filter: got %q, want %q", got, "dcim.device")
+		}
+		if got := query.Get("user_name"); got != "admin" {
+			t.Errorf("user_name filter: got %q, want %q", got, "admin")
+		}
+
+		fmt.Fprint(w, `{"count": 3, "results": [
+			{"id": 12, "time": "2020-04-25T10:02:00Z", "user_name": "admin", "request_id": "c", "action": {"value": "update"}, "changed_object_type": "dcim.device", "changed_object_id": 7, "object_data": {"name": "edge1", "status": "active"}},
+			{"id": 11, "time": "2020-04-25T10:01:00Z", "user_name": "admin", "request_id": "b", "action": {"value": "create"}, "changed_object_type": "dcim.device", "changed_object_id": 8, "object_data": {"name": "edge2", "status": "planned"}},
+			{"id": 10, "time": "2020-04-25T10:00:00Z", "user_name": "admin", "request_id": "a", "action": {"value": "create"}, "changed_object_type": "dcim.device", "changed_object_id": 7, "object_data": {"name": "edge1", "status": "planned"}}
+		]}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxObjectChangesSchema(), map[string]interface{}{
+		"changed_object_type": "dcim.device",
+		"user_name":           "admin",
+	})
+
+	if err := dataSourceNetboxObjectChangesRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("changes.#"); got != 3 {
+		t.Fatalf("changes: got %d, want 3", got)
+	}
+
+	expected := map[string]interface{}{
+		"changes.0.object_change_id":    12,
+		"changes.0.action":              "update",
+		"changes.0.changed_object_type": "dcim.device",
+		"changes.0.changed_object_id":   7,
+		"changes.0.object_data":         `{"name":"edge1","status":"active"}`,
+		"changes.0.prechange_data":      `{"name":"edge1","status":"planned"}`,
+		"changes.1.object_change_id":    11,
+		"changes.1.prechange_data":      "",
+		"changes.2.object_change_id":    10,
+		"changes.2.action":              "create",
+		"changes.2.prechange_data":      "",
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+}
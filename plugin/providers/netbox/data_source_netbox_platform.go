@@ -0,0 +1,109 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func dataSourceNetboxPlatform() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxPlatformRead,
+		Schema: dataSourceNetboxPlatformSchema(),
+	}
+}
+
+func dataSourceNetboxPlatformSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"platform_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"manufacturer_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"napalm_driver": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"napalm_args": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = dcim.NewDcimPlatformsListParams()
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if slug, slugOk := d.GetOk("slug"); slugOk {
+		slugStr := slug.(string)
+		parm.SetSlug(&slugStr)
+	}
+
+	log.Debugf("Executing DcimPlatformsList against Netbox: %v", parm)
+
+	platforms, err := netboxPlatformList(netboxClient, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPlatformsList: %v", err)
+
+		return err
+	}
+
+	if len(platforms) != 1 {
+		return fmt.Errorf("Expected exactly one platform matching name %q and slug %q, found %d", d.Get("name").(string), d.Get("slug").(string), len(platforms))
+	}
+
+	platform := platforms[0]
+
+	d.SetId(strconv.FormatInt(platform.ID, 10))
+	d.Set("platform_id", platform.ID)
+	d.Set("name", platform.Name)
+	d.Set("slug", platform.Slug)
+
+	var manufacturerID int64
+	if platform.Manufacturer != nil {
+		manufacturerID = platform.Manufacturer.ID
+	}
+	d.Set("manufacturer_id", manufacturerID)
+
+	d.Set("napalm_driver", platform.NapalmDriver)
+
+	var napalmArgs string
+	if len(platform.NapalmArgs) > 0 && string(platform.NapalmArgs) != "null" {
+		napalmArgs, err = structure.NormalizeJsonString(string(platform.NapalmArgs))
+
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("napalm_args", napalmArgs)
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package netbox
+
+import (
+	"math"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func dataSourceNetboxPowerPort() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxPowerPortRead,
+		Schema: dataSourceNetboxPowerPortSchema(),
+	}
+}
+
+func dataSourceNetboxPowerPortSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"power_port_id": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"device_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"maximum_draw": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"allocated_draw": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"power_feed_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"power_feed": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"power_panel_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"voltage": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"amperage": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"phase": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"max_utilization": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"available_power": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		// Power allocated on the feed, which includes the other devices
+		// plugged into the same PDU
+		"feed_allocated_draw": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		// Percentage of the available power of the feed that is allocated
+		"utilization": {
+			Type:     schema.TypeFloat,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxPowerPortRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_port_id").(int))
+
+	log.Debugf("Executing DcimPowerPortsRead against Netbox")
+
+	result, err := netboxPowerPortRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortsRead against Netbox: %v", err)
+
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(id, 10))
+	d.Set("name", result.Name)
+
+	if result.Device != nil {
+		d.Set("device_id", result.Device.ID)
+	}
+
+	var maximumDraw, allocatedDraw int64
+	if result.MaximumDraw != nil {
+		maximumDraw = *result.MaximumDraw
+	}
+	if result.AllocatedDraw != nil {
+		allocatedDraw = *result.AllocatedDraw
+	}
+	d.Set("maximum_draw", maximumDraw)
+	d.Set("allocated_draw", allocatedDraw)
+
+	feedID, feedPortID, err := netboxPowerPortFeed(netboxClient, id)
+
+	if err != nil {
+		return err
+	}
+
+	if feedID == 0 {
+		log.Debugf("Power port %d is not connected to a power feed", id)
+
+		return nil
+	}
+
+	var feedParams = dcim.NewDcimPowerFeedsReadParams().WithID(feedID)
+
+	feed, err := netboxClient.Dcim.DcimPowerFeedsRead(feedParams, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerFeedsRead against Netbox: %v", err)
+
+		return err
+	}
+
+	d.Set("power_feed_id", feed.Payload.ID)
+	d.Set("power_feed", feed.Payload.Name)
+
+	if feed.Payload.PowerPanel != nil {
+		d.Set("power_panel_id", feed.Payload.PowerPanel.ID)
+	}
+
+	var phase string
+	if feed.Payload.Phase != nil {
+		phase = *feed.Payload.Phase.Value
+	}
+	d.Set("phase", phase)
+
+	d.Set("voltage", feed.Payload.Voltage)
+	d.Set("amperage", feed.Payload.Amperage)
+	d.Set("max_utilization", feed.Payload.MaxUtilization)
+
+	// Same calculation Netbox uses for PowerFeed.available_power.
+	kva := math.Abs(float64(feed.Payload.Voltage)) * float64(feed.Payload.Amperage) * float64(feed.Payload.MaxUtilization) / 100
+	if phase == "three-phase" {
+		kva = kva * 1.732
+	}
+	availablePower := int64(math.Round(kva))
+	d.Set("available_power", availablePower)
+
+	feedPort := result
+	if feedPortID != id {
+		feedPort, err = netboxPowerPortRead(netboxClient, feedPortID)
+
+		if err != nil {
+			log.Debugf("Failed to execute DcimPowerPortsRead against Netbox: %v", err)
+
+			return err
+		}
+	}
+
+	feedAllocatedDraw, err := netboxPowerPortDraw(netboxClient, feedPort, make(map[int64]bool))
+
+	if err != nil {
+		return err
+	}
+	d.Set("feed_allocated_draw", feedAllocatedDraw)
+
+	var utilization float64
+	if availablePower > 0 {
+		utilization = float64(feedAllocatedDraw) / float64(availablePower) * 100
+	}
+	d.Set("utilization", utilization)
+
+	return nil
+}
+
+// netboxPowerPortFeed returns the ID of the power feed the given power port
+// ultimately draws from, or 0 if there is none, together with the ID of the
+// power port that is cabled to the feed. Netbox 2.7 ends the trace of a power
+// port at the power outlet it is plugged into, so when that outlet belongs to
+// a PDU the trace is continued from the power port the outlet is fed by.
+func netboxPowerPortFeed(c *client.NetBox, id int64) (int64, int64, error) {
+	seen := make(map[int64]bool)
+
+	for !seen[id] {
+		seen[id] = true
+
+		log.Debugf("Tracing cable path of power port %d", id)
+
+		segments, err := netboxCableTrace(c, "dcim/power-ports", id)
+
+		if err != nil {
+			log.Debugf("Failed to trace power port %d: %v", id, err)
+
+			return 0, 0, err
+		}
+
+		if len(segments) == 0 || segments[len(segments)-1].Far == nil {
+			return 0, 0, nil
+		}
+
+		far := segments[len(segments)-1].Far
+
+		switch far.Type() {
+		case "dcim.powerfeed":
+			return far.ID, id, nil
+		case "dcim.poweroutlet":
+			outlet, err := netboxPowerOutletRead(c, far.ID)
+
+			if err != nil {
+				log.Debugf("Failed to execute DcimPowerOutletsRead against Netbox: %v", err)
+
+				return 0, 0, err
+			}
+
+			if outlet.PowerPort == nil {
+				return 0, 0, nil
+			}
+
+			id = outlet.PowerPort.ID
+		default:
+			return 0, 0, nil
+		}
+	}
+
+	// The PDUs feed each other in a loop
+	return 0, 0, nil
+}
+
+// netboxPowerPortDraw returns the power allocated to a power port. As in
+// Netbox, a power port without an allocated draw of its own, such as the
+// inlet of a PDU, draws the sum of the power ports plugged into the outlets
+// it feeds.
+func netboxPowerPortDraw(c *client.NetBox, port *powerPortRecord, seen map[int64]bool) (int64, error) {
+	if port.AllocatedDraw != nil {
+		return *port.AllocatedDraw, nil
+	}
+
+	if seen[port.ID] || port.Device == nil {
+		return 0, nil
+	}
+	seen[port.ID] = true
+
+	deviceIDStr := strconv.FormatInt(port.Device.ID, 10)
+	cabled := "true"
+
+	var parm = dcim.NewDcimPowerOutletsListParams().
+		WithDeviceID(&deviceIDStr).
+		WithCabled(&cabled)
+
+	log.Debugf("Executing DcimPowerOutletsList against Netbox: %v", parm)
+
+	outlets, err := netboxPowerOutletList(c, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerOutletsList: %v", err)
+
+		return 0, err
+	}
+
+	var draw int64
+
+	for _, outlet := range outlets {
+		if outlet.PowerPort == nil || outlet.PowerPort.ID != port.ID || outlet.ConnectedEndpoint == nil {
+			continue
+		}
+
+		child, err := netboxPowerPortRead(c, outlet.ConnectedEndpoint.ID)
+
+		if err != nil {
+			log.Debugf("Failed to execute DcimPowerPortsRead against Netbox: %v", err)
+
+			return 0, err
+		}
+
+		childDraw, err := netboxPowerPortDraw(c, child, seen)
+
+		if err != nil {
+			return 0, err
+		}
+
+		draw += childDraw
+	}
+
+	return draw, nil
+}
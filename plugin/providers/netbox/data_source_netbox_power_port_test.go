@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// testPowerNetbox answers for a rack with two servers plugged into the
+// outlets of a PDU, whose inlet is cabled to power feed 7. Power port 1 of
+// the first server draws 200W and power port 3 of the second 300W.
+func testPowerNetbox(t *testing.T) *ProviderNetboxClient {
+	return testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dcim/power-ports/1/trace/":
+			fmt.Fprint(w, `[[
+				{"id": 1, "url": "http://netbox/api/dcim/power-ports/1/", "name": "PSU1"},
+				{"id": 50},
+				{"id": 10, "url": "http://netbox/api/dcim/power-outlets/10/", "name": "Outlet 1"}
+			]]`)
+		case "/api/dcim/power-ports/2/trace/":
+			fmt.Fprint(w, `[[
+				{"id": 2, "url": "http://netbox/api/dcim/power-ports/2/", "name": "Inlet"},
+				{"id": 51},
+				{"id": 7, "url": "http://netbox/api/dcim/power-feeds/7/", "name": "Feed A"}
+			]]`)
+		case "/api/dcim/power-ports/5/trace/":
+			fmt.Fprint(w, `[]`)
+		case "/api/dcim/power-outlets/10/":
+			fmt.Fprint(w, `{"id": 10, "device": {"id": 20}, "name": "Outlet 1", "power_port": {"id": 2}}`)
+		case "/api/dcim/power-ports/1/":
+			fmt.Fprint(w, `{"id": 1, "device": {"id": 30}, "name": "PSU1", "maximum_draw": 400, "allocated_draw": 200}`)
+		case "/api/dcim/power-ports/2/":
+			fmt.Fprint(w, `{"id": 2, "device": {"id": 20}, "name": "Inlet", "allocated_draw": null}`)
+		case "/api/dcim/power-ports/3/":
+			fmt.Fprint(w, `{"id": 3, "device": {"id": 31}, "name": "PSU1", "allocated_draw": 300}`)
+		case "/api/dcim/power-ports/5/":
+			fmt.Fprint(w, `{"id": 5, "device": {"id": 32}, "name": "PSU1", "allocated_draw": 100}`)
+		case "/api/dcim/power-outlets/":
+			if got := r.URL.Query().Get("device_id"); got != "20" {
+				t.Errorf("device_id filter: got %q, want %q", got, "20")
+			}
+
+			// Outlet 12 is fed by another inlet of the PDU and is ignored.
+			fmt.Fprint(w, `{"count": 3, "results": [
+				{"id": 10, "device": {"id": 20}, "power_port": {"id": 2}, "connected_endpoint": {"id": 1}},
+				{"id": 11, "device": {"id": 20}, "power_port": {"id": 2}, "connected_endpoint": {"id": 3}},
+				{"id": 12, "device": {"id": 20}, "power_port": {"id": 4}, "connected_endpoint": {"id": 6}}
+			]}`)
+		case "/api/dcim/power-feeds/7/":
+			fmt.Fprint(w, `{"id": 7, "name": "Feed A", "power_panel": {"id": 8}, "phase": {"value": "single-phase", "label": "Single phase"}, "voltage": 230, "amperage": 16, "max_utilization": 80}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestDataSourceNetboxPowerPortRead(t *testing.T) {
+	meta := testPowerNetbox(t)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxPowerPortSchema(), map[string]interface{}{
+		"power_port_id": 1,
+	})
+
+	if err := dataSourceNetboxPowerPortRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"allocated_draw":      200,
+		"power_feed_id":       7,
+		"power_feed":          "Feed A",
+		"power_panel_id":      8,
+		"available_power":     2944,
+		"feed_allocated_draw": 500,
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+
+	if got := d.Get("utilization").(float64); math.Abs(got-500.0/2944*100) > 1e-9 {
+		t.Errorf("utilization: got %v, want %v", got, 500.0/2944*100)
+	}
+}
+
+func TestDataSourceNetboxPowerPortReadNotConnected(t *testing.T) {
+	meta := testPowerNetbox(t)
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxPowerPortSchema(), map[string]interface{}{
+		"power_port_id": 5,
+	})
+
+	if err := dataSourceNetboxPowerPortRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("power_feed_id"); got != 0 {
+		t.Errorf("power_feed_id: got %#v, want 0", got)
+	}
+
+	if got := d.Get("utilization"); got != 0.0 {
+		t.Errorf("utilization: got %#v, want 0", got)
+	}
+}
+
+func TestNetboxPowerPortFeed(t *testing.T) {
+	meta := testPowerNetbox(t)
+
+	cases := []struct {
+		name             string
+		id               int64
+		expectedFeed     int64
+		expectedFeedPort int64
+	}{
+		{name: "through PDU", id: 1, expectedFeed: 7, expectedFeedPort: 2},
+		{name: "direct", id: 2, expectedFeed: 7, expectedFeedPort: 2},
+		{name: "not connected", id: 5},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			feedID, feedPortID, err := netboxPowerPortFeed(meta.client, tc.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if feedID != tc.expectedFeed || feedPortID != tc.expectedFeedPort {
+				t.Fatalf("expected feed %d via port %d, got feed %d via port %d", tc.expectedFeed, tc.expectedFeedPort, feedID, feedPortID)
+			}
+		})
+	}
+}
+
+func TestNetboxPowerPortFeedLoop(t *testing.T) {
+	// Two PDUs plugged into each other.
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dcim/power-ports/1/trace/":
+			fmt.Fprint(w, `[[{"id": 1}, {"id": 50}, {"id": 10, "url": "http://netbox/api/dcim/power-outlets/10/"}]]`)
+		case "/api/dcim/power-ports/2/trace/":
+			fmt.Fprint(w, `[[{"id": 2}, {"id": 51}, {"id": 11, "url": "http://netbox/api/dcim/power-outlets/11/"}]]`)
+		case "/api/dcim/power-outlets/10/":
+			fmt.Fprint(w, `{"id": 10, "power_port": {"id": 2}}`)
+		case "/api/dcim/power-outlets/11/":
+			fmt.Fprint(w, `{"id": 11, "power_port": {"id": 1}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	feedID, _, err := netboxPowerPortFeed(meta.client, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feedID != 0 {
+		t.Fatalf("expected no feed, got %d", feedID)
+	}
+}
@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceNetboxReportRun() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxReportRunRead,
+		Schema: dataSourceNetboxReportRunSchema(),
+	}
+}
+
+func dataSourceNetboxReportRunSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		// Report to run as "<module>.<report>"
+		"report": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"report", "script"},
+		},
+		// Custom script to run as "<module>.<script>"
+		"script": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"report", "script"},
+		},
+		// Input variables of the script
+		"script_data": {
+			Type:          schema.TypeMap,
+			Optional:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{"report"},
+		},
+		// Seconds to wait for the run to finish
+		"timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		// Return an error when the run fails
+		"fail_on_failure": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		// "passed" or "failed"
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"failed": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"logs": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					// Test method of the report that logged the line
+					"method": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"level": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					// Object the report logged the line for
+					"object": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		// Output returned by the script
+		"output": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxReportRunRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	timeout := time.Duration(d.Get("timeout").(int)) * time.Second
+
+	var name string
+	var failed bool
+	var output string
+	logs := make([]map[string]interface{}, 0)
+
+	if report, reportOk := d.GetOk("report"); reportOk {
+		name = report.(string)
+
+		log.Debugf("Executing ExtrasReportsRun against Netbox: %s", name)
+
+		out, err := netboxReportRun(netboxClient, name, timeout)
+
+		if err != nil {
+			log.Debugf("Failed to execute ExtrasReportsRun: %v", err)
+
+			return err
+		}
+
+		if out.Result == nil {
+			return fmt.Errorf("Netbox returned no result for report %s", name)
+		}
+
+		failed = out.Result.Failed
+
+		for _, method := range out.TestMethods {
+			for _, line := range out.Result.Data[method].Log {
+				if len(line) != 5 {
+					continue
+				}
+
+				entry := map[string]interface{}{
+					"method": method,
+				}
+
+				if line[1] != nil {
+					entry["level"] = *line[1]
+				}
+
+				if line[2] != nil {
+					entry["object"] = *line[2]
+				}
+
+				if line[4] != nil {
+					entry["message"] = *line[4]
+				}
+
+				logs = append(logs, entry)
+			}
+		}
+	} else {
+		name = d.Get("script").(string)
+
+		log.Debugf("Executing ExtrasScriptsRun against Netbox: %s", name)
+
+		out, err := netboxScriptRun(netboxClient, name, d.Get("script_data").(map[string]interface{}), timeout)
+
+		if err != nil {
+			log.Debugf("Failed to execute ExtrasScriptsRun: %v", err)
+
+			return err
+		}
+
+		for _, line := range out.Log {
+			if line.Status == "failure" {
+				failed = true
+			}
+
+			logs = append(logs, map[string]interface{}{
+				"level":   line.Status,
+				"message": line.Message,
+			})
+		}
+
+		output = out.Output
+	}
+
+	status := "passed"
+	if failed {
+		status = "failed"
+	}
+
+	if failed && d.Get("fail_on_failure").(bool) {
+		failures := make([]string, 0)
+
+		for _, entry := range logs {
+			if entry["level"] == "failure" {
+				object, _ := entry["object"].(string)
+				message, _ := entry["message"].(string)
+
+				failures = append(failures, strings.TrimSpace(object+" "+message))
+			}
+		}
+
+		return fmt.Errorf("Netbox run of %s failed:\n%s", name, strings.Join(failures, "\n"))
+	}
+
+	d.SetId(name)
+	d.Set("status", status)
+	d.Set("failed", failed)
+	d.Set("logs", logs)
+	d.Set("output", output)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceNetboxReportRunReadReport(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/extras/reports/devices.DeviceReport/run/" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+
+			return
+		}
+
+		fmt.Fprint(w, `{
+			"module": "devices",
+			"name": "DeviceReport",
+			"test_methods": ["test_serials", "test_platforms"],
+			"result": {
+				"id": 1,
+				"failed": true,
+				"data": {
+					"test_serials": {"success": 1, "failure": 1, "log": [
+						["2020-05-01T12:00:00", "failure", "edge1", "/dcim/devices/7/", "Missing serial"],
+						["2020-05-01T12:00:00", "success", "edge2", "/dcim/devices/8/", null]
+					]},
+					"test_platforms": {"success": 0, "failure": 0, "log": [
+						["2020-05-01T12:00:00", "info", null, null, "No devices checked"]
+					]}
+				}
+			}
+		}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxReportRunSchema(), map[string]interface{}{
+		"report": "devices.DeviceReport",
+	})
+
+	if err := dataSourceNetboxReportRunRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("status"); got != "failed" {
+		t.Errorf("status: got %q, want %q", got, "failed")
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"method": "test_serials", "level": "failure", "object": "edge1", "message": "Missing serial"},
+		map[string]interface{}{"method": "test_serials", "level": "success", "object": "edge2", "message": ""},
+		map[string]interface{}{"method": "test_platforms", "level": "info", "object": "", "message": "No devices checked"},
+	}
+
+	if got := d.Get("logs"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("logs: got %#v, want %#v", got, expected)
+	}
+}
+
+func TestDataSourceNetboxReportRunReadFailOnFailure(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"module": "devices",
+			"name": "DeviceReport",
+			"test_methods": ["test_serials"],
+			"result": {"failed": true, "data": {"test_serials": {"log": [
+				["2020-05-01T12:00:00", "failure", "edge1", "/dcim/devices/7/", "Missing serial"]
+			]}}}
+		}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxReportRunSchema(), map[string]interface{}{
+		"report":          "devices.DeviceReport",
+		"fail_on_failure": true,
+	})
+
+	err := dataSourceNetboxReportRunRead(d, meta)
+	if err == nil {
+		t.Fatal("expected an error for a failed report")
+	}
+
+	if want := "Netbox run of devices.DeviceReport failed:\nedge1 Missing serial"; err.Error() != want {
+		t.Errorf("error: got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestDataSourceNetboxReportRunReadScript(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/extras/scripts/provision.NewSite/" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+
+		// Scripts are always run without committing their changes, as a
+		// data source must not modify Netbox.
+		expected := map[string]interface{}{
+			"data":   map[string]interface{}{"site_name": "ams1"},
+			"commit": false,
+		}
+
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("body: got %#v, want %#v", body, expected)
+		}
+
+		fmt.Fprint(w, `{"log": [{"status": "success", "message": "Created site ams1"}], "output": "done"}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxReportRunSchema(), map[string]interface{}{
+		"script":      "provision.NewSite",
+		"script_data": map[string]interface{}{"site_name": "ams1"},
+	})
+
+	if err := dataSourceNetboxReportRunRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("status"); got != "passed" {
+		t.Errorf("status: got %q, want %q", got, "passed")
+	}
+
+	if got := d.Get("output"); got != "done" {
+		t.Errorf("output: got %q, want %q", got, "done")
+	}
+}
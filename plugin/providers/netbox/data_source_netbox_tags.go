@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func dataSourceNetboxTags() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxTagsRead,
+		Schema: dataSourceNetboxTagsSchema(),
+	}
+}
+
+func dataSourceNetboxTagsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"query": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tag_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"slug": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"color": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"tagged_items": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetboxTagsRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = extras.NewExtrasTagsListParams()
+
+	if query, queryOk := d.GetOk("query"); queryOk {
+		queryStr := query.(string)
+		parm.SetQ(&queryStr)
+	}
+
+	tags := make([]*models.Tag, 0)
+
+	for {
+		offset := int64(len(tags))
+		parm.SetOffset(&offset)
+
+		log.Debugf("Executing ExtrasTagsList against Netbox: %v", parm)
+
+		out, err := netboxClient.Extras.ExtrasTagsList(parm, nil)
+
+		if err != nil {
+			log.Debugf("Failed to execute ExtrasTagsList: %v", err)
+
+			return err
+		}
+
+		tags = append(tags, out.Payload.Results...)
+
+		if len(out.Payload.Results) == 0 || int64(len(tags)) >= *out.Payload.Count {
+			break
+		}
+	}
+
+	tagList := make([]map[string]interface{}, 0, len(tags))
+
+	for _, tag := range tags {
+		tagList = append(tagList, map[string]interface{}{
+			"tag_id":       tag.ID,
+			"name":         *tag.Name,
+			"slug":         *tag.Slug,
+			"color":        tag.Color,
+			"description":  tag.Comments,
+			"tagged_items": tag.TaggedItems,
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(d.Get("query").(string))))
+	d.Set("tags", tagList)
+
+	return nil
+}
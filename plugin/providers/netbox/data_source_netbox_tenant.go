@@ -0,0 +1,94 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+)
+
+func dataSourceNetboxTenant() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxTenantRead,
+		Schema: dataSourceNetboxTenantSchema(),
+	}
+}
+
+func dataSourceNetboxTenantSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			AtLeastOneOf: []string{"name", "slug"},
+		},
+		"tenant_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"group_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxTenantRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = tenancy.NewTenancyTenantsListParams()
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if slug, slugOk := d.GetOk("slug"); slugOk {
+		slugStr := slug.(string)
+		parm.SetSlug(&slugStr)
+	}
+
+	log.Debugf("Executing TenancyTenantsList against Netbox: %v", parm)
+
+	out, err := netboxClient.Tenancy.TenancyTenantsList(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantsList: %v", err)
+
+		return err
+	}
+
+	if len(out.Payload.Results) != 1 {
+		return fmt.Errorf("Expected exactly one tenant matching name %q and slug %q, found %d", d.Get("name").(string), d.Get("slug").(string), len(out.Payload.Results))
+	}
+
+	tenant := out.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(tenant.ID, 10))
+	d.Set("tenant_id", tenant.ID)
+	d.Set("name", tenant.Name)
+	d.Set("slug", tenant.Slug)
+
+	var groupID int64
+	if tenant.Group != nil {
+		groupID = tenant.Group.ID
+	}
+	d.Set("group_id", groupID)
+
+	d.Set("description", tenant.Description)
+
+	return nil
+}
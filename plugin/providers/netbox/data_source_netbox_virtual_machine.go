@@ -0,0 +1,493 @@
+package netbox
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func dataSourceNetboxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxVirtualMachineRead,
+		Schema: dataSourceNetboxVirtualMachineSchema(),
+	}
+}
+
+func dataSourceNetboxVirtualMachineSchema() map[string]*schema.Schema {
+	lookup := []string{"virtual_machine_id", "name", "cluster_id", "tag"}
+
+	s := dataSourceNetboxVirtualMachineAttributes()
+
+	s["virtual_machine_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		AtLeastOneOf: lookup,
+	}
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		AtLeastOneOf: lookup,
+	}
+	s["cluster_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		AtLeastOneOf: lookup,
+	}
+	// Slug of a tag the virtual machine has
+	s["tag"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		AtLeastOneOf: lookup,
+	}
+	// The config context as rendered by Netbox from all matching config
+	// contexts and the local context data, encoded as JSON.
+	s["config_context"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return s
+}
+
+// dataSourceNetboxVirtualMachineAttributes returns the attributes of a
+// virtual machine shared by the virtual machine data sources.
+func dataSourceNetboxVirtualMachineAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"virtual_machine_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cluster_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"site_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"site": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"role_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"tenant_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"platform_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"vcpus": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"memory_mb": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"disk_gb": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"primary_ip4_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"primary_ip4": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"primary_ip6_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"primary_ip6": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"comments": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"interfaces": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"interface_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"mac_address": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"enabled": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"mtu": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"ip_addresses": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"custom_fields": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"local_context_data": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceNetboxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = virtualization.NewVirtualizationVirtualMachinesListParams()
+
+	if id, idOk := d.GetOk("virtual_machine_id"); idOk {
+		idStr := strconv.Itoa(id.(int))
+		parm.SetID(&idStr)
+	}
+
+	if name, nameOk := d.GetOk("name"); nameOk {
+		nameStr := name.(string)
+		parm.SetName(&nameStr)
+	}
+
+	if clusterID, clusterIDOk := d.GetOk("cluster_id"); clusterIDOk {
+		clusterIDStr := strconv.Itoa(clusterID.(int))
+		parm.SetClusterID(&clusterIDStr)
+	}
+
+	if tag, tagOk := d.GetOk("tag"); tagOk {
+		tagStr := tag.(string)
+		parm.SetTag(&tagStr)
+	}
+
+	log.Debugf("Executing VirtualizationVirtualMachinesList against Netbox: %v", parm)
+
+	vms, err := netboxVirtualMachineList(netboxClient, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationVirtualMachinesList: %v", err)
+
+		return err
+	}
+
+	if len(vms) != 1 {
+		return fmt.Errorf("Expected exactly one virtual machine matching the given filters, found %d", len(vms))
+	}
+
+	// Netbox only renders the config context when a single virtual machine
+	// is retrieved, so the match is read again by ID.
+	vm, err := netboxVirtualMachineRead(netboxClient, vms[0].ID)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationVirtualMachinesRead: %v", err)
+
+		return err
+	}
+
+	interfaces, err := netboxVirtualMachineInterfaces(netboxClient, []int64{vm.ID})
+
+	if err != nil {
+		return err
+	}
+
+	attributes, err := flattenVirtualMachine(vm, interfaces[vm.ID])
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(vm.ID, 10))
+
+	for key, value := range attributes {
+		d.Set(key, value)
+	}
+
+	configContext, err := flattenJSON(vm.ConfigContext)
+
+	if err != nil {
+		return err
+	}
+	d.Set("config_context", configContext)
+
+	return nil
+}
+
+// flattenVirtualMachine converts a virtual machine and its interfaces, as
+// returned by netboxVirtualMachineInterfaces, into the attributes of
+// dataSourceNetboxVirtualMachineAttributes.
+func flattenVirtualMachine(vm *virtualMachineRecord, interfaces []map[string]interface{}) (map[string]interface{}, error) {
+	attributes := map[string]interface{}{
+		"virtual_machine_id": vm.ID,
+		"name":               vm.Name,
+		"comments":           vm.Comments,
+		"tags":               vm.Tags,
+		"custom_fields":      flattenCustomFields(vm.CustomFields),
+	}
+
+	if vm.Cluster != nil {
+		attributes["cluster_id"] = vm.Cluster.ID
+	}
+
+	if vm.Site != nil {
+		attributes["site_id"] = vm.Site.ID
+		attributes["site"] = vm.Site.Name
+	}
+
+	if vm.Role != nil {
+		attributes["role_id"] = vm.Role.ID
+	}
+
+	if vm.Tenant != nil {
+		attributes["tenant_id"] = vm.Tenant.ID
+	}
+
+	if vm.Platform != nil {
+		attributes["platform_id"] = vm.Platform.ID
+	}
+
+	if vm.Vcpus != nil {
+		attributes["vcpus"] = *vm.Vcpus
+	}
+
+	if vm.Memory != nil {
+		attributes["memory_mb"] = *vm.Memory
+	}
+
+	if vm.Disk != nil {
+		attributes["disk_gb"] = *vm.Disk
+	}
+
+	if vm.Status != nil {
+		attributes["status"] = vm.Status.Value
+	}
+
+	if vm.PrimaryIp4 != nil {
+		attributes["primary_ip4_id"] = vm.PrimaryIp4.ID
+		attributes["primary_ip4"] = vm.PrimaryIp4.Address
+	}
+
+	if vm.PrimaryIp6 != nil {
+		attributes["primary_ip6_id"] = vm.PrimaryIp6.ID
+		attributes["primary_ip6"] = vm.PrimaryIp6.Address
+	}
+
+	localContextData, err := flattenJSON(vm.LocalContextData)
+
+	if err != nil {
+		return nil, err
+	}
+	attributes["local_context_data"] = localContextData
+
+	if interfaces == nil {
+		interfaces = make([]map[string]interface{}, 0)
+	}
+	attributes["interfaces"] = interfaces
+
+	return attributes, nil
+}
+
+// netboxVirtualMachineInterfacesBatchSize is the number of virtual machines
+// whose interfaces and IP addresses are looked up with a single request.
+const netboxVirtualMachineInterfacesBatchSize = 50
+
+// netboxVirtualMachineInterfaces lists the interfaces of the given virtual
+// machines together with the IP addresses assigned to each of them, grouped
+// by virtual machine ID. Netbox can filter both lists on any number of
+// virtual machines, so they are fetched in batches instead of once per
+// virtual machine.
+func netboxVirtualMachineInterfaces(c *client.NetBox, vmIDs []int64) (map[int64][]map[string]interface{}, error) {
+	ifaces := make([]*models.VirtualMachineInterface, 0)
+	ips := make([]*models.IPAddress, 0)
+
+	for start := 0; start < len(vmIDs); start += netboxVirtualMachineInterfacesBatchSize {
+		end := start + netboxVirtualMachineInterfacesBatchSize
+		if end > len(vmIDs) {
+			end = len(vmIDs)
+		}
+
+		vmIDStrs := make([]string, 0, end-start)
+		for _, vmID := range vmIDs[start:end] {
+			vmIDStrs = append(vmIDStrs, strconv.FormatInt(vmID, 10))
+		}
+
+		log.Debugf("Executing VirtualizationInterfacesList against Netbox: %v", vmIDStrs)
+
+		batchIfaces, err := netboxVirtualMachineInterfaceList(c, vmIDStrs)
+
+		if err != nil {
+			log.Debugf("Failed to execute VirtualizationInterfacesList: %v", err)
+
+			return nil, err
+		}
+
+		ifaces = append(ifaces, batchIfaces...)
+
+		log.Debugf("Executing IpamIPAddressesList against Netbox: %v", vmIDStrs)
+
+		batchIPs, err := netboxVirtualMachineIPAddressList(c, vmIDStrs)
+
+		if err != nil {
+			log.Debugf("Failed to execute IpamIPAddressesList: %v", err)
+
+			return nil, err
+		}
+
+		ips = append(ips, batchIPs...)
+	}
+
+	addresses := make(map[int64][]string)
+
+	for _, ip := range ips {
+		if ip.Interface != nil && ip.Address != nil {
+			addresses[ip.Interface.ID] = append(addresses[ip.Interface.ID], *ip.Address)
+		}
+	}
+
+	interfaces := make(map[int64][]map[string]interface{})
+
+	for _, iface := range ifaces {
+		if iface.VirtualMachine == nil {
+			continue
+		}
+
+		entry := map[string]interface{}{
+			"interface_id": iface.ID,
+			"name":         *iface.Name,
+			"enabled":      iface.Enabled,
+			"description":  iface.Description,
+			"ip_addresses": addresses[iface.ID],
+		}
+
+		if iface.MacAddress != nil {
+			entry["mac_address"] = *iface.MacAddress
+		}
+
+		if iface.Mtu != nil {
+			entry["mtu"] = *iface.Mtu
+		}
+
+		interfaces[iface.VirtualMachine.ID] = append(interfaces[iface.VirtualMachine.ID], entry)
+	}
+
+	return interfaces, nil
+}
+
+// netboxVirtualMachineInterfaceList lists the interfaces of all of the
+// given virtual machines. go-netbox only accepts a single virtual machine ID
+// as filter, so the request is made directly.
+func netboxVirtualMachineInterfaceList(c *client.NetBox, vmIDs []string) ([]*models.VirtualMachineInterface, error) {
+	ifaces := make([]*models.VirtualMachineInterface, 0)
+
+	for {
+		offset := strconv.Itoa(len(ifaces))
+
+		var page struct {
+			Count   int64                             `json:"count"`
+			Results []*models.VirtualMachineInterface `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "virtualization_interfaces_list", "GET", "/virtualization/interfaces/", netboxVirtualMachineIDsParams(vmIDs, offset), &page)
+		if err != nil {
+			return nil, err
+		}
+
+		ifaces = append(ifaces, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(ifaces)) >= page.Count {
+			break
+		}
+	}
+
+	return ifaces, nil
+}
+
+// netboxVirtualMachineIPAddressList lists the IP addresses assigned to the
+// interfaces of all of the given virtual machines.
+func netboxVirtualMachineIPAddressList(c *client.NetBox, vmIDs []string) ([]*models.IPAddress, error) {
+	ips := make([]*models.IPAddress, 0)
+
+	for {
+		offset := strconv.Itoa(len(ips))
+
+		var page struct {
+			Count   int64               `json:"count"`
+			Results []*models.IPAddress `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "ipam_ip-addresses_list", "GET", "/ipam/ip-addresses/", netboxVirtualMachineIDsParams(vmIDs, offset), &page)
+		if err != nil {
+			return nil, err
+		}
+
+		ips = append(ips, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(ips)) >= page.Count {
+			break
+		}
+	}
+
+	return ips, nil
+}
+
+// netboxVirtualMachineIDsParams filters a list on the given virtual machines,
+// starting at offset.
+func netboxVirtualMachineIDsParams(vmIDs []string, offset string) runtime.ClientRequestWriterFunc {
+	return func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := r.SetQueryParam("virtual_machine_id", vmIDs...); err != nil {
+			return err
+		}
+
+		return r.SetQueryParam("offset", offset)
+	}
+}
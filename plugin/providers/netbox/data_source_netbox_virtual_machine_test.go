@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceNetboxVirtualMachineRead(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/virtualization/virtual-machines/":
+			if got := r.URL.Query().Get("id"); got != "12" {
+				t.Errorf("id filter: got %q, want %q", got, "12")
+			}
+
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 12, "name": "web1", "cluster": {"id": 2}, "local_context_data": null, "config_context": null}]}`)
+		case "/api/virtualization/virtual-machines/12/":
+			fmt.Fprint(w, `{"id": 12, "name": "web1", "cluster": {"id": 2}, "local_context_data": null, "config_context": {"users": [{"name": "deploy"}]}}`)
+		case "/api/virtualization/interfaces/":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 40, "name": "eth0", "virtual_machine": {"id": 12, "name": "web1"}, "enabled": true, "mtu": 1500, "mac_address": "00:16:3E:00:00:01"}]}`)
+		case "/api/ipam/ip-addresses/":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": 90, "address": "192.0.2.10/24", "interface": {"id": 40, "name": "eth0", "virtual_machine": {"id": 12, "name": "web1"}}}]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxVirtualMachineSchema(), map[string]interface{}{
+		"virtual_machine_id": 12,
+	})
+
+	if err := dataSourceNetboxVirtualMachineRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"name":               "web1",
+		"cluster_id":         2,
+		"local_context_data": "",
+		"config_context":     `{"users":[{"name":"deploy"}]}`,
+	}
+
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Errorf("%s: got %#v, want %#v", k, got, v)
+		}
+	}
+
+	expectedInterfaces := []interface{}{
+		map[string]interface{}{
+			"interface_id": 40,
+			"name":         "eth0",
+			"mac_address":  "00:16:3E:00:00:01",
+			"enabled":      true,
+			"mtu":          1500,
+			"description":  "",
+			"ip_addresses": []interface{}{"192.0.2.10/24"},
+		},
+	}
+
+	if got := d.Get("interfaces"); !reflect.DeepEqual(got, expectedInterfaces) {
+		t.Errorf("interfaces: got %#v, want %#v", got, expectedInterfaces)
+	}
+}
+
+func TestDataSourceNetboxVirtualMachineReadAmbiguous(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 2, "results": [{"id": 1, "name": "web"}, {"id": 2, "name": "web"}]}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceNetboxVirtualMachineSchema(), map[string]interface{}{
+		"name": "web",
+	})
+
+	if err := dataSourceNetboxVirtualMachineRead(d, meta); err == nil {
+		t.Fatal("expected an error when several virtual machines match")
+	}
+}
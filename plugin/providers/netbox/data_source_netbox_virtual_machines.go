@@ -0,0 +1,142 @@
+package netbox
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+func dataSourceNetboxVirtualMachines() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceNetboxVirtualMachinesRead,
+		Schema: dataSourceNetboxVirtualMachinesSchema(),
+	}
+}
+
+func dataSourceNetboxVirtualMachinesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"site_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"role_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"tenant_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		// Slug of a tag the virtual machines have
+		"tag": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"virtual_machines": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: dataSourceNetboxVirtualMachineAttributes(),
+			},
+		},
+	}
+}
+
+func dataSourceNetboxVirtualMachinesRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	var parm = virtualization.NewVirtualizationVirtualMachinesListParams()
+
+	filters := make([]string, 0)
+
+	if clusterID, clusterIDOk := d.GetOk("cluster_id"); clusterIDOk {
+		clusterIDStr := strconv.Itoa(clusterID.(int))
+		parm.SetClusterID(&clusterIDStr)
+		filters = append(filters, fmt.Sprintf("cluster_id=%s", clusterIDStr))
+	}
+
+	if siteID, siteIDOk := d.GetOk("site_id"); siteIDOk {
+		siteIDStr := strconv.Itoa(siteID.(int))
+		parm.SetSiteID(&siteIDStr)
+		filters = append(filters, fmt.Sprintf("site_id=%s", siteIDStr))
+	}
+
+	if roleID, roleIDOk := d.GetOk("role_id"); roleIDOk {
+		roleIDStr := strconv.Itoa(roleID.(int))
+		parm.SetRoleID(&roleIDStr)
+		filters = append(filters, fmt.Sprintf("role_id=%s", roleIDStr))
+	}
+
+	if tenantID, tenantIDOk := d.GetOk("tenant_id"); tenantIDOk {
+		tenantIDStr := strconv.Itoa(tenantID.(int))
+		parm.SetTenantID(&tenantIDStr)
+		filters = append(filters, fmt.Sprintf("tenant_id=%s", tenantIDStr))
+	}
+
+	if status, statusOk := d.GetOk("status"); statusOk {
+		statusStr := status.(string)
+		parm.SetStatus(&statusStr)
+		filters = append(filters, fmt.Sprintf("status=%s", statusStr))
+	}
+
+	if tag, tagOk := d.GetOk("tag"); tagOk {
+		tagStr := tag.(string)
+		parm.SetTag(&tagStr)
+		filters = append(filters, fmt.Sprintf("tag=%s", tagStr))
+	}
+
+	log.Debugf("Executing VirtualizationVirtualMachinesList against Netbox: %v", parm)
+
+	vms, err := netboxVirtualMachineList(netboxClient, parm)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationVirtualMachinesList: %v", err)
+
+		return err
+	}
+
+	vmIDs := make([]int64, 0, len(vms))
+	for _, vm := range vms {
+		vmIDs = append(vmIDs, vm.ID)
+	}
+
+	interfaces, err := netboxVirtualMachineInterfaces(netboxClient, vmIDs)
+
+	if err != nil {
+		return err
+	}
+
+	vmList := make([]map[string]interface{}, 0, len(vms))
+
+	for i := range vms {
+		attributes, err := flattenVirtualMachine(&vms[i], interfaces[vms[i].ID])
+
+		if err != nil {
+			return err
+		}
+
+		vmList = append(vmList, attributes)
+	}
+
+	sort.Strings(filters)
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(filters, "&"))))
+	d.Set("virtual_machines", vmList)
+
+	return nil
+}
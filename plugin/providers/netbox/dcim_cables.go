@@ -0,0 +1,58 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// cableRecord is a cable as returned by the Netbox API. go-netbox decodes
+// the nested termination objects of a cable into string maps, which fails for
+// any real termination, so cables are read directly instead. Its writable
+// model omits an empty type, label, color or length unit, so cables are also
+// written as a plain field map.
+type cableRecord struct {
+	ID               int64  `json:"id"`
+	TerminationAType string `json:"termination_a_type"`
+	TerminationAID   int64  `json:"termination_a_id"`
+	TerminationBType string `json:"termination_b_type"`
+	TerminationBID   int64  `json:"termination_b_id"`
+	Type             string `json:"type"`
+	Status           *struct {
+		Value string `json:"value"`
+	} `json:"status"`
+	Label      string `json:"label"`
+	Color      string `json:"color"`
+	Length     *int64 `json:"length"`
+	LengthUnit *struct {
+		Value string `json:"value"`
+	} `json:"length_unit"`
+}
+
+// Peer returns the termination on the opposite end of the cable from the
+// given one.
+func (r *cableRecord) Peer(terminationType string, id int64) (string, int64) {
+	if r.TerminationAType == terminationType && r.TerminationAID == id {
+		return r.TerminationBType, r.TerminationBID
+	}
+
+	return r.TerminationAType, r.TerminationAID
+}
+
+// netboxCableRead reads a single cable by ID.
+func netboxCableRead(c *client.NetBox, id int64) (*cableRecord, error) {
+	var cable cableRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_cables_read", "GET", "/dcim/cables/{id}/", params, &cable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cable, nil
+}
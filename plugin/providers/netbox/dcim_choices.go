@@ -0,0 +1,255 @@
+package netbox
+
+// dcimInterfaceTypeChoices lists the physical and virtual interface types accepted by Netbox.
+var dcimInterfaceTypeChoices = []string{
+	"virtual",
+	"lag",
+	"100base-tx",
+	"1000base-t",
+	"2.5gbase-t",
+	"5gbase-t",
+	"10gbase-t",
+	"10gbase-cx4",
+	"1000base-x-gbic",
+	"1000base-x-sfp",
+	"10gbase-x-sfpp",
+	"10gbase-x-xfp",
+	"10gbase-x-xenpak",
+	"10gbase-x-x2",
+	"25gbase-x-sfp28",
+	"40gbase-x-qsfpp",
+	"50gbase-x-sfp28",
+	"100gbase-x-cfp",
+	"100gbase-x-cfp2",
+	"200gbase-x-cfp2",
+	"100gbase-x-cfp4",
+	"100gbase-x-cpak",
+	"100gbase-x-qsfp28",
+	"200gbase-x-qsfp56",
+	"400gbase-x-qsfpdd",
+	"400gbase-x-osfp",
+	"ieee802.11a",
+	"ieee802.11g",
+	"ieee802.11n",
+	"ieee802.11ac",
+	"ieee802.11ad",
+	"ieee802.11ax",
+	"gsm",
+	"cdma",
+	"lte",
+	"sonet-oc3",
+	"sonet-oc12",
+	"sonet-oc48",
+	"sonet-oc192",
+	"sonet-oc768",
+	"sonet-oc1920",
+	"sonet-oc3840",
+	"1gfc-sfp",
+	"2gfc-sfp",
+	"4gfc-sfp",
+	"8gfc-sfpp",
+	"16gfc-sfpp",
+	"32gfc-sfp28",
+	"128gfc-sfp28",
+	"inifiband-sdr",
+	"inifiband-ddr",
+	"inifiband-qdr",
+	"inifiband-fdr10",
+	"inifiband-fdr",
+	"inifiband-edr",
+	"inifiband-hdr",
+	"inifiband-ndr",
+	"inifiband-xdr",
+	"t1",
+	"e1",
+	"t3",
+	"e3",
+	"cisco-stackwise",
+	"cisco-stackwise-plus",
+	"cisco-flexstack",
+	"cisco-flexstack-plus",
+	"juniper-vcp",
+	"extreme-summitstack",
+	"extreme-summitstack-128",
+	"extreme-summitstack-256",
+	"extreme-summitstack-512",
+	"other",
+}
+
+// dcimConsolePortTypeChoices lists the connector types accepted for console and console server ports.
+var dcimConsolePortTypeChoices = []string{
+	"de-9",
+	"db-25",
+	"rj-11",
+	"rj-12",
+	"rj-45",
+	"usb-a",
+	"usb-b",
+	"usb-c",
+	"usb-mini-a",
+	"usb-mini-b",
+	"usb-micro-a",
+	"usb-micro-b",
+	"other",
+}
+
+// dcimPowerPortTypeChoices lists the plug types accepted for power ports.
+var dcimPowerPortTypeChoices = []string{
+	"iec-60320-c6",
+	"iec-60320-c8",
+	"iec-60320-c14",
+	"iec-60320-c16",
+	"iec-60320-c20",
+	"iec-60309-p-n-e-4h",
+	"iec-60309-p-n-e-6h",
+	"iec-60309-p-n-e-9h",
+	"iec-60309-2p-e-4h",
+	"iec-60309-2p-e-6h",
+	"iec-60309-2p-e-9h",
+	"iec-60309-3p-e-4h",
+	"iec-60309-3p-e-6h",
+	"iec-60309-3p-e-9h",
+	"iec-60309-3p-n-e-4h",
+	"iec-60309-3p-n-e-6h",
+	"iec-60309-3p-n-e-9h",
+	"nema-5-15p",
+	"nema-5-20p",
+	"nema-5-30p",
+	"nema-5-50p",
+	"nema-6-15p",
+	"nema-6-20p",
+	"nema-6-30p",
+	"nema-6-50p",
+	"nema-l5-15p",
+	"nema-l5-20p",
+	"nema-l5-30p",
+	"nema-l5-50p",
+	"nema-l6-20p",
+	"nema-l6-30p",
+	"nema-l6-50p",
+	"cs6361c",
+	"cs6365c",
+	"cs8165c",
+	"cs8265c",
+	"cs8365c",
+	"cs8465c",
+	"ita-e",
+	"ita-f",
+	"ita-ef",
+	"ita-g",
+	"ita-h",
+	"ita-i",
+	"ita-j",
+	"ita-k",
+	"ita-l",
+	"ita-m",
+	"ita-n",
+	"ita-o",
+}
+
+// dcimPowerOutletTypeChoices lists the receptacle types accepted for power outlets.
+var dcimPowerOutletTypeChoices = []string{
+	"iec-60320-c5",
+	"iec-60320-c7",
+	"iec-60320-c13",
+	"iec-60320-c15",
+	"iec-60320-c19",
+	"iec-60309-p-n-e-4h",
+	"iec-60309-p-n-e-6h",
+	"iec-60309-p-n-e-9h",
+	"iec-60309-2p-e-4h",
+	"iec-60309-2p-e-6h",
+	"iec-60309-2p-e-9h",
+	"iec-60309-3p-e-4h",
+	"iec-60309-3p-e-6h",
+	"iec-60309-3p-e-9h",
+	"iec-60309-3p-n-e-4h",
+	"iec-60309-3p-n-e-6h",
+	"iec-60309-3p-n-e-9h",
+	"nema-5-15r",
+	"nema-5-20r",
+	"nema-5-30r",
+	"nema-5-50r",
+	"nema-6-15r",
+	"nema-6-20r",
+	"nema-6-30r",
+	"nema-6-50r",
+	"nema-l5-15r",
+	"nema-l5-20r",
+	"nema-l5-30r",
+	"nema-l5-50r",
+	"nema-l6-20r",
+	"nema-l6-30r",
+	"nema-l6-50r",
+	"CS6360C",
+	"CS6364C",
+	"CS8164C",
+	"CS8264C",
+	"CS8364C",
+	"CS8464C",
+	"ita-e",
+	"ita-f",
+	"ita-g",
+	"ita-h",
+	"ita-i",
+	"ita-j",
+	"ita-k",
+	"ita-l",
+	"ita-m",
+	"ita-n",
+	"ita-o",
+}
+
+// dcimPortTypeChoices lists the connector types accepted for front and rear ports.
+var dcimPortTypeChoices = []string{
+	"8p8c",
+	"110-punch",
+	"bnc",
+	"fc",
+	"lc",
+	"lc-apc",
+	"lsh",
+	"lsh-apc",
+	"mpo",
+	"mtrj",
+	"sc",
+	"sc-apc",
+	"st",
+}
+
+// dcimCableTypeChoices lists the cable media types accepted by Netbox.
+var dcimCableTypeChoices = []string{
+	"cat3",
+	"cat5",
+	"cat5e",
+	"cat6",
+	"cat6a",
+	"cat7",
+	"dac-active",
+	"dac-passive",
+	"coaxial",
+	"mmf",
+	"mmf-om1",
+	"mmf-om2",
+	"mmf-om3",
+	"mmf-om4",
+	"smf",
+	"smf-os1",
+	"smf-os2",
+	"aoc",
+	"power",
+}
+
+// dcimCableTerminationCompatibility maps each cable termination type to the
+// termination types Netbox allows on the other end of the same cable.
+var dcimCableTerminationCompatibility = map[string][]string{
+	"dcim.consoleport":            {"dcim.consoleserverport", "dcim.frontport", "dcim.rearport"},
+	"dcim.consoleserverport":      {"dcim.consoleport", "dcim.frontport", "dcim.rearport"},
+	"dcim.powerport":              {"dcim.poweroutlet", "dcim.powerfeed"},
+	"dcim.poweroutlet":            {"dcim.powerport"},
+	"dcim.powerfeed":              {"dcim.powerport"},
+	"dcim.interface":              {"dcim.interface", "circuits.circuittermination", "dcim.frontport", "dcim.rearport"},
+	"dcim.frontport":              {"dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"dcim.rearport":               {"dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"circuits.circuittermination": {"dcim.interface", "dcim.frontport", "dcim.rearport"},
+}
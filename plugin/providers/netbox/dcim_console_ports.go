@@ -0,0 +1,83 @@
+package netbox
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+// consolePortRecord is a console port or console server port as returned by
+// the Netbox API. go-netbox decodes the connected endpoint of a port into a
+// string map, which fails as soon as the port is cabled, so console ports
+// and console server ports are read and written directly instead.
+type consolePortRecord struct {
+	ID     int64 `json:"id"`
+	Device *struct {
+		ID          int64  `json:"id"`
+		DisplayName string `json:"display_name"`
+	} `json:"device"`
+	Name string `json:"name"`
+	Type *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Cable       *struct {
+		ID int64 `json:"id"`
+	} `json:"cable"`
+	ConnectedEndpointType string              `json:"connected_endpoint_type"`
+	ConnectedEndpoint     *cableTraceEndpoint `json:"connected_endpoint"`
+	ConnectionStatus      *struct {
+		Value bool `json:"value"`
+	} `json:"connection_status"`
+}
+
+// netboxConsolePortRead reads a single console port, or console server port
+// when componentPath is "dcim/console-server-ports", by ID.
+func netboxConsolePortRead(c *client.NetBox, componentPath string, id int64) (*consolePortRecord, error) {
+	var port consolePortRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, strings.Replace(componentPath, "/", "_", -1)+"_read", "GET", "/"+componentPath+"/{id}/", params, &port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &port, nil
+}
+
+// netboxConsoleConnectionList lists the connected console ports matching the
+// given parameters.
+func netboxConsoleConnectionList(c *client.NetBox, params *dcim.DcimConsoleConnectionsListParams) ([]consolePortRecord, error) {
+	ports := make([]consolePortRecord, 0)
+
+	for {
+		offset := int64(len(ports))
+		params.SetOffset(&offset)
+
+		var page struct {
+			Count   int64               `json:"count"`
+			Results []consolePortRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "dcim_console-connections_list", "GET", "/dcim/console-connections/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		ports = append(ports, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(ports)) >= page.Count {
+			break
+		}
+	}
+
+	return ports, nil
+}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+// deviceRecord is a device as returned by the Netbox API. Only the fields
+// exposed by the device data source are decoded.
+type deviceRecord struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Site *struct {
+		ID int64 `json:"id"`
+	} `json:"site"`
+	LocalContextData json.RawMessage `json:"local_context_data"`
+	ConfigContext    json.RawMessage `json:"config_context"`
+}
+
+// netboxDeviceRead reads a single device by ID.
+func netboxDeviceRead(c *client.NetBox, id int64) (*deviceRecord, error) {
+	var device deviceRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_devices_read", "GET", "/dcim/devices/{id}/", params, &device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// netboxDeviceList lists the devices matching the given filters.
+func netboxDeviceList(c *client.NetBox, params *dcim.DcimDevicesListParams) ([]deviceRecord, error) {
+	devices := make([]deviceRecord, 0)
+
+	for {
+		offset := int64(len(devices))
+		params.SetOffset(&offset)
+
+		var page struct {
+			Count   int64          `json:"count"`
+			Results []deviceRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "dcim_devices_list", "GET", "/dcim/devices/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(devices)) >= page.Count {
+			break
+		}
+	}
+
+	return devices, nil
+}
+
+// virtualChassisMember is the virtual chassis membership of a device.
+type virtualChassisMember struct {
+	DeviceID   int64  `json:"id"`
+	VcPosition *int64 `json:"vc_position"`
+	VcPriority *int64 `json:"vc_priority"`
+}
+
+// netboxVirtualChassisMembers lists the devices assigned to a virtual
+// chassis. Devices are decoded directly rather than through go-netbox, whose
+// device model fails on any structured config context.
+func netboxVirtualChassisMembers(c *client.NetBox, virtualChassisID int64) ([]virtualChassisMember, error) {
+	members := make([]virtualChassisMember, 0)
+
+	virtualChassisIDStr := strconv.FormatInt(virtualChassisID, 10)
+
+	for {
+		offset := int64(len(members))
+
+		params := dcim.NewDcimDevicesListParams().
+			WithVirtualChassisID(&virtualChassisIDStr).
+			WithOffset(&offset)
+
+		var page struct {
+			Count   int64                  `json:"count"`
+			Results []virtualChassisMember `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "dcim_devices_list", "GET", "/dcim/devices/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(members)) >= page.Count {
+			break
+		}
+	}
+
+	return members, nil
+}
+
+// netboxDevicePatch partially updates a device with only the given fields.
+// The go-netbox device model always sends its required fields, which makes
+// it unusable for PATCH requests.
+func netboxDevicePatch(c *client.NetBox, id int64, fields map[string]interface{}) error {
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := r.SetPathParam("id", strconv.FormatInt(id, 10)); err != nil {
+			return err
+		}
+
+		return r.SetBodyParam(fields)
+	}
+
+	return netboxRawOperation(c, "dcim_devices_partial_update", "PATCH", "/dcim/devices/{id}/", params, nil)
+}
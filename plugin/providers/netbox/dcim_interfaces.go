@@ -0,0 +1,59 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// interfaceRecord is a device interface as returned by the Netbox API.
+// go-netbox decodes the connected endpoint of an interface into a string map,
+// which fails as soon as the interface is cabled, and its writable model
+// omits a false enabled or mgmt_only as well as any cleared LAG, VLAN, MTU,
+// MAC address or mode, so interfaces are read and written directly instead.
+type interfaceRecord struct {
+	ID     int64 `json:"id"`
+	Device *struct {
+		ID int64 `json:"id"`
+	} `json:"device"`
+	Name string `json:"name"`
+	Type *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	Enabled bool `json:"enabled"`
+	Lag     *struct {
+		ID int64 `json:"id"`
+	} `json:"lag"`
+	Mtu        *int64  `json:"mtu"`
+	MacAddress *string `json:"mac_address"`
+	MgmtOnly   bool    `json:"mgmt_only"`
+	Mode       *struct {
+		Value string `json:"value"`
+	} `json:"mode"`
+	UntaggedVlan *struct {
+		ID int64 `json:"id"`
+	} `json:"untagged_vlan"`
+	TaggedVlans []struct {
+		ID int64 `json:"id"`
+	} `json:"tagged_vlans"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// netboxInterfaceRead reads a single device interface by ID.
+func netboxInterfaceRead(c *client.NetBox, id int64) (*interfaceRecord, error) {
+	var iface interfaceRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_interfaces_read", "GET", "/dcim/interfaces/{id}/", params, &iface)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iface, nil
+}
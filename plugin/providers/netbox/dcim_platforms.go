@@ -0,0 +1,69 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+// platformRecord is a platform as returned by the Netbox API. go-netbox
+// models the NAPALM arguments as a string while Netbox stores and returns a
+// JSON object, so platforms are read and written directly instead.
+type platformRecord struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Manufacturer *struct {
+		ID int64 `json:"id"`
+	} `json:"manufacturer"`
+	NapalmDriver string          `json:"napalm_driver"`
+	NapalmArgs   json.RawMessage `json:"napalm_args"`
+}
+
+// netboxPlatformRead reads a single platform by ID.
+func netboxPlatformRead(c *client.NetBox, id int64) (*platformRecord, error) {
+	var platform platformRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_platforms_read", "GET", "/dcim/platforms/{id}/", params, &platform)
+	if err != nil {
+		return nil, err
+	}
+
+	return &platform, nil
+}
+
+// netboxPlatformList lists the platforms matching the given filters.
+func netboxPlatformList(c *client.NetBox, params *dcim.DcimPlatformsListParams) ([]platformRecord, error) {
+	platforms := make([]platformRecord, 0)
+
+	for {
+		offset := int64(len(platforms))
+		params.SetOffset(&offset)
+
+		var page struct {
+			Count   int64            `json:"count"`
+			Results []platformRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "dcim_platforms_list", "GET", "/dcim/platforms/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		platforms = append(platforms, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(platforms)) >= page.Count {
+			break
+		}
+	}
+
+	return platforms, nil
+}
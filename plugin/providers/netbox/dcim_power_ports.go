@@ -0,0 +1,123 @@
+package netbox
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+// powerPortRecord is a power port as returned by the Netbox API. go-netbox
+// decodes the connected endpoint of a power port into a string map, which
+// fails as soon as the port is cabled, so power ports are read and written
+// directly instead.
+type powerPortRecord struct {
+	ID     int64 `json:"id"`
+	Device *struct {
+		ID int64 `json:"id"`
+	} `json:"device"`
+	Name string `json:"name"`
+	Type *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	MaximumDraw   *int64   `json:"maximum_draw"`
+	AllocatedDraw *int64   `json:"allocated_draw"`
+	Description   string   `json:"description"`
+	Tags          []string `json:"tags"`
+	Cable         *struct {
+		ID int64 `json:"id"`
+	} `json:"cable"`
+	ConnectionStatus *struct {
+		Value bool `json:"value"`
+	} `json:"connection_status"`
+}
+
+// powerOutletRecord is a power outlet as returned by the Netbox API, read
+// directly for the same reason as powerPortRecord.
+type powerOutletRecord struct {
+	ID     int64 `json:"id"`
+	Device *struct {
+		ID int64 `json:"id"`
+	} `json:"device"`
+	Name string `json:"name"`
+	Type *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	PowerPort *struct {
+		ID int64 `json:"id"`
+	} `json:"power_port"`
+	FeedLeg *struct {
+		Value string `json:"value"`
+	} `json:"feed_leg"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Cable       *struct {
+		ID int64 `json:"id"`
+	} `json:"cable"`
+	ConnectedEndpoint *cableTraceEndpoint `json:"connected_endpoint"`
+	ConnectionStatus  *struct {
+		Value bool `json:"value"`
+	} `json:"connection_status"`
+}
+
+// netboxPowerPortRead reads a single power port by ID.
+func netboxPowerPortRead(c *client.NetBox, id int64) (*powerPortRecord, error) {
+	var port powerPortRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_power-ports_read", "GET", "/dcim/power-ports/{id}/", params, &port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &port, nil
+}
+
+// netboxPowerOutletRead reads a single power outlet by ID.
+func netboxPowerOutletRead(c *client.NetBox, id int64) (*powerOutletRecord, error) {
+	var outlet powerOutletRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "dcim_power-outlets_read", "GET", "/dcim/power-outlets/{id}/", params, &outlet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outlet, nil
+}
+
+// netboxPowerOutletList lists the power outlets matching the given filters.
+func netboxPowerOutletList(c *client.NetBox, params *dcim.DcimPowerOutletsListParams) ([]powerOutletRecord, error) {
+	outlets := make([]powerOutletRecord, 0)
+
+	for {
+		offset := int64(len(outlets))
+		params.SetOffset(&offset)
+
+		var page struct {
+			Count   int64               `json:"count"`
+			Results []powerOutletRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "dcim_power-outlets_list", "GET", "/dcim/power-outlets/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		outlets = append(outlets, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(outlets)) >= page.Count {
+			break
+		}
+	}
+
+	return outlets, nil
+}
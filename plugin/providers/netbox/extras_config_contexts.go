@@ -0,0 +1,64 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// configContextAssignment is one of the objects a config context is assigned
+// to, such as a site or a platform.
+type configContextAssignment struct {
+	ID int64 `json:"id"`
+}
+
+// configContextRecord is a config context as returned by the Netbox API.
+// go-netbox models the context data as a string while Netbox returns a JSON
+// object, and it omits a false is_active from the request body, so config
+// contexts are read and written directly instead.
+type configContextRecord struct {
+	ID            int64                     `json:"id"`
+	Name          string                    `json:"name"`
+	Weight        int64                     `json:"weight"`
+	Description   string                    `json:"description"`
+	IsActive      bool                      `json:"is_active"`
+	Regions       []configContextAssignment `json:"regions"`
+	Sites         []configContextAssignment `json:"sites"`
+	Roles         []configContextAssignment `json:"roles"`
+	Platforms     []configContextAssignment `json:"platforms"`
+	ClusterGroups []configContextAssignment `json:"cluster_groups"`
+	Clusters      []configContextAssignment `json:"clusters"`
+	TenantGroups  []configContextAssignment `json:"tenant_groups"`
+	Tenants       []configContextAssignment `json:"tenants"`
+	Tags          []string                  `json:"tags"`
+	Data          json.RawMessage           `json:"data"`
+}
+
+// flattenConfigContextAssignments returns the IDs of the assigned objects.
+func flattenConfigContextAssignments(assignments []configContextAssignment) []int64 {
+	ids := make([]int64, 0, len(assignments))
+	for _, a := range assignments {
+		ids = append(ids, a.ID)
+	}
+
+	return ids
+}
+
+// netboxConfigContextRead reads a single config context by ID.
+func netboxConfigContextRead(c *client.NetBox, id int64) (*configContextRecord, error) {
+	var configContext configContextRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "extras_config-contexts_read", "GET", "/extras/config-contexts/{id}/", params, &configContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configContext, nil
+}
@@ -0,0 +1,33 @@
+package netbox
+
+// exportTemplateContentTypes are the content types export templates can be
+// assigned to in Netbox 2.7.
+var exportTemplateContentTypes = []string{
+	"circuits.circuit",
+	"circuits.provider",
+	"dcim.cable",
+	"dcim.consoleport",
+	"dcim.device",
+	"dcim.devicetype",
+	"dcim.interface",
+	"dcim.inventoryitem",
+	"dcim.manufacturer",
+	"dcim.powerfeed",
+	"dcim.powerpanel",
+	"dcim.powerport",
+	"dcim.rack",
+	"dcim.rackgroup",
+	"dcim.region",
+	"dcim.site",
+	"dcim.virtualchassis",
+	"ipam.aggregate",
+	"ipam.ipaddress",
+	"ipam.prefix",
+	"ipam.service",
+	"ipam.vlan",
+	"ipam.vrf",
+	"secrets.secret",
+	"tenancy.tenant",
+	"virtualization.cluster",
+	"virtualization.virtualmachine",
+}
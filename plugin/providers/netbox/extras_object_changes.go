@@ -0,0 +1,67 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// objectChangeRecord is a changelog entry as returned by the Netbox API.
+// go-netbox decodes the changed object and its data into string maps, which
+// fails for any object with numeric or nested fields, and its list
+// parameters lack the time range filters, so the changelog is read directly
+// instead.
+type objectChangeRecord struct {
+	ID       int64  `json:"id"`
+	Time     string `json:"time"`
+	UserName string `json:"user_name"`
+	// RequestID is shared by all changes made by the same request
+	RequestID string `json:"request_id"`
+	Action    *struct {
+		Value string `json:"value"`
+	} `json:"action"`
+	ChangedObjectType string          `json:"changed_object_type"`
+	ChangedObjectID   int64           `json:"changed_object_id"`
+	ObjectData        json.RawMessage `json:"object_data"`
+}
+
+// netboxObjectChangeList lists the changelog entries matching the given
+// query filters, newest first.
+func netboxObjectChangeList(c *client.NetBox, filters map[string]string) ([]objectChangeRecord, error) {
+	changes := make([]objectChangeRecord, 0)
+
+	for {
+		offset := strconv.Itoa(len(changes))
+
+		params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			for key, value := range filters {
+				if err := r.SetQueryParam(key, value); err != nil {
+					return err
+				}
+			}
+
+			return r.SetQueryParam("offset", offset)
+		}
+
+		var page struct {
+			Count   int64                `json:"count"`
+			Results []objectChangeRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "extras_object-changes_list", "GET", "/extras/object-changes/", params, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(changes)) >= page.Count {
+			break
+		}
+	}
+
+	return changes, nil
+}
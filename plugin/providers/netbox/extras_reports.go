@@ -0,0 +1,93 @@
+package netbox
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// reportRecord is a report together with its latest result as returned by
+// the Netbox API. The Netbox API schema leaves the report and script
+// responses undocumented, so go-netbox discards the report result and has no
+// operation for running a script at all, and both are called directly
+// instead. Netbox 2.7 runs both within the API request, so the request
+// timeout is the time to wait for the result.
+type reportRecord struct {
+	Module      string   `json:"module"`
+	Name        string   `json:"name"`
+	TestMethods []string `json:"test_methods"`
+	Result      *struct {
+		ID      int64  `json:"id"`
+		Created string `json:"created"`
+		Failed  bool   `json:"failed"`
+		Data    map[string]struct {
+			Success int64 `json:"success"`
+			Info    int64 `json:"info"`
+			Warning int64 `json:"warning"`
+			Failure int64 `json:"failure"`
+			// Log lines are [time, level, object, object URL, message]
+			Log [][]*string `json:"log"`
+		} `json:"data"`
+	} `json:"result"`
+}
+
+// scriptOutputRecord is the output of a custom script run.
+type scriptOutputRecord struct {
+	Log []struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"log"`
+	Output string `json:"output"`
+}
+
+// netboxReportRun runs the report with the given "<module>.<report>" name and
+// returns the report with its new result.
+func netboxReportRun(c *client.NetBox, name string, timeout time.Duration) (*reportRecord, error) {
+	var report reportRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := r.SetTimeout(timeout); err != nil {
+			return err
+		}
+
+		return r.SetPathParam("id", name)
+	}
+
+	err := netboxRawOperation(c, "extras_reports_run", "POST", "/extras/reports/{id}/run/", params, &report)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// netboxScriptRun runs the custom script with the given "<module>.<script>"
+// name with data as its input variables. The changes made by the script are
+// always rolled back, as the script runs on every read of a data source.
+func netboxScriptRun(c *client.NetBox, name string, data map[string]interface{}, timeout time.Duration) (*scriptOutputRecord, error) {
+	var output scriptOutputRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if err := r.SetTimeout(timeout); err != nil {
+			return err
+		}
+
+		if err := r.SetPathParam("id", name); err != nil {
+			return err
+		}
+
+		return r.SetBodyParam(map[string]interface{}{
+			"data":   data,
+			"commit": false,
+		})
+	}
+
+	err := netboxRawOperation(c, "extras_scripts_run", "POST", "/extras/scripts/{id}/", params, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
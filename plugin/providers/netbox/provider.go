@@ -31,15 +31,68 @@ func providerSchema() map[string]*schema.Schema {
 			DefaultFunc: schema.EnvDefaultFunc("NETBOX_ENDPOINT_ADDR", nil),
 			Description: "Endpoint of your Netbox instance",
 		},
+		"private_key": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			DefaultFunc:   schema.EnvDefaultFunc("NETBOX_PRIVATE_KEY", nil),
+			ConflictsWith: []string{"private_key_file"},
+			Description:   "PEM encoded private RSA key of the Netbox user, used to get a session key for managing secrets",
+		},
+		"private_key_file": &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			DefaultFunc:   schema.EnvDefaultFunc("NETBOX_PRIVATE_KEY_FILE", nil),
+			ConflictsWith: []string{"private_key"},
+			Description:   "Path of a file holding the private RSA key of the Netbox user",
+		},
 	}
 }
 
 // List of supported resources and their configuration fields.
 func providerResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
+		// Dcim
+		"netbox_dcim_manufacturer":          resourceNetboxDcimManufacturer(),
+		"netbox_dcim_device_type":           resourceNetboxDcimDeviceType(),
+		"netbox_dcim_interface_template":    resourceNetboxDcimInterfaceTemplate(),
+		"netbox_dcim_console_port_template": resourceNetboxDcimConsolePortTemplate(),
+		"netbox_dcim_power_port_template":   resourceNetboxDcimPowerPortTemplate(),
+		"netbox_dcim_interface":             resourceNetboxDcimInterface(),
+		"netbox_dcim_cable":                 resourceNetboxDcimCable(),
+		"netbox_dcim_console_port":          resourceNetboxDcimConsolePort(),
+		"netbox_dcim_console_server_port":   resourceNetboxDcimConsoleServerPort(),
+		"netbox_dcim_power_panel":           resourceNetboxDcimPowerPanel(),
+		"netbox_dcim_power_feed":            resourceNetboxDcimPowerFeed(),
+		"netbox_dcim_power_port":            resourceNetboxDcimPowerPort(),
+		"netbox_dcim_power_outlet":          resourceNetboxDcimPowerOutlet(),
+		"netbox_dcim_front_port":            resourceNetboxDcimFrontPort(),
+		"netbox_dcim_rear_port":             resourceNetboxDcimRearPort(),
+		"netbox_dcim_virtual_chassis":       resourceNetboxDcimVirtualChassis(),
+		"netbox_dcim_inventory_item":        resourceNetboxDcimInventoryItem(),
+		"netbox_dcim_platform":              resourceNetboxDcimPlatform(),
+		"netbox_dcim_device_role":           resourceNetboxDcimDeviceRole(),
+		// Circuits
+		"netbox_circuits_provider":            resourceNetboxCircuitsProvider(),
+		"netbox_circuits_circuit_type":        resourceNetboxCircuitsCircuitType(),
+		"netbox_circuits_circuit":             resourceNetboxCircuitsCircuit(),
+		"netbox_circuits_circuit_termination": resourceNetboxCircuitsCircuitTermination(),
+		// Tenancy
+		"netbox_tenancy_tenant":       resourceNetboxTenancyTenant(),
+		"netbox_tenancy_tenant_group": resourceNetboxTenancyTenantGroup(),
+		// Secrets
+		"netbox_secrets_secret_role": resourceNetboxSecretsSecretRole(),
+		"netbox_secrets_secret":      resourceNetboxSecretsSecret(),
+		// Extras
+		"netbox_extras_tag":              resourceNetboxExtrasTag(),
+		"netbox_extras_config_context":   resourceNetboxExtrasConfigContext(),
+		"netbox_extras_export_template":  resourceNetboxExtrasExportTemplate(),
+		"netbox_extras_image_attachment": resourceNetboxExtrasImageAttachment(),
 		// Ipam
 		"netbox_ipam_ip_address":                resourceNetboxIpamIPAddress(),
 		"netbox_ipam_prefixes_available_ips":    resourceNetboxIpamPrefixesAvailableIps(),
+		"netbox_virtualization_cluster_type":    resourceNetboxVirtualizationClusterType(),
+		"netbox_virtualization_cluster_group":   resourceNetboxVirtualizationClusterGroup(),
 		"netbox_virtualization_cluster":         resourceNetboxVirtualizationCluster(),
 		"netbox_virtualization_virtual_machine": resourceNetboxVirtualizationVirtualMachine(),
 		"netbox_virtualization_interface":       resourceNetboxVirtualizationInterface(),
@@ -49,8 +102,24 @@ func providerResources() map[string]*schema.Resource {
 // List of supported data sources and their configuration fields.
 func providerDataSourcesMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
+		"netbox_console_connections":    dataSourceNetboxConsoleConnections(),
+		"netbox_device":                 dataSourceNetboxDevice(),
+		"netbox_device_role":            dataSourceNetboxDeviceRole(),
+		"netbox_front_port_trace":       dataSourceNetboxFrontPortTrace(),
+		"netbox_inventory_items":        dataSourceNetboxInventoryItems(),
+		"netbox_platform":               dataSourceNetboxPlatform(),
+		"netbox_power_port":             dataSourceNetboxPowerPort(),
 		"netbox_ip_address":             dataSourceNetboxIPAddress(),
 		"netbox_prefixes_available_ips": dataSourceNetboxPrefixesAvailableIps(),
+		"netbox_cluster_type":           dataSourceNetboxClusterType(),
+		"netbox_cluster_group":          dataSourceNetboxClusterGroup(),
+		"netbox_virtual_machine":        dataSourceNetboxVirtualMachine(),
+		"netbox_virtual_machines":       dataSourceNetboxVirtualMachines(),
+		"netbox_tenant":                 dataSourceNetboxTenant(),
+		"netbox_tags":                   dataSourceNetboxTags(),
+		"netbox_export_template":        dataSourceNetboxExportTemplate(),
+		"netbox_object_changes":         dataSourceNetboxObjectChanges(),
+		"netbox_report_run":             dataSourceNetboxReportRun(),
 	}
 }
 
@@ -59,8 +128,10 @@ func providerDataSourcesMap() map[string]*schema.Resource {
 // interacts with the API.
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		AppID:    d.Get("app_id").(string),
-		Endpoint: d.Get("endpoint").(string),
+		AppID:          d.Get("app_id").(string),
+		Endpoint:       d.Get("endpoint").(string),
+		PrivateKey:     d.Get("private_key").(string),
+		PrivateKeyFile: d.Get("private_key_file").(string),
 	}
 	return config.Client()
 }
@@ -1,11 +1,17 @@
 package netbox
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
+	openapi_runtimeclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
 )
 
 var testAccProvider *schema.Provider
@@ -42,3 +48,22 @@ func testAccPreCheck(t *testing.T) {
 func testProviderConfigure(d *schema.ResourceData) (interface{}, error) {
 	return nil, nil
 }
+
+// testNetboxClient returns a provider client talking to a local server that
+// answers every request with the given handler as JSON.
+func testNetboxClient(t *testing.T, handler http.HandlerFunc) *ProviderNetboxClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := openapi_runtimeclient.New(u.Host, client.DefaultBasePath, []string{"http"})
+
+	return &ProviderNetboxClient{client: client.New(transport, strfmt.Default)}
+}
@@ -0,0 +1,76 @@
+package netbox
+
+import (
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// netboxRawOperation submits a request for an endpoint that go-netbox either
+// does not implement or models incorrectly, and decodes the JSON response
+// into out. The request is sent through the same runtime client, so the
+// endpoint and token configured on the provider apply as usual.
+func netboxRawOperation(c *client.NetBox, id string, method string, pathPattern string, params runtime.ClientRequestWriterFunc, out interface{}) error {
+	if params == nil {
+		params = func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			return nil
+		}
+	}
+
+	_, err := c.Transport.Submit(&runtime.ClientOperation{
+		ID:                 id,
+		Method:             method,
+		PathPattern:        pathPattern,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Params:             params,
+		Reader: runtime.ClientResponseReaderFunc(func(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if response.Code()/100 != 2 {
+				body, _ := ioutil.ReadAll(response.Body())
+
+				return nil, runtime.NewAPIError(id, string(body), response.Code())
+			}
+
+			if out == nil {
+				return nil, nil
+			}
+
+			if err := consumer.Consume(response.Body(), out); err != nil && err != io.EOF {
+				return nil, err
+			}
+
+			return out, nil
+		}),
+	})
+
+	return err
+}
+
+// netboxRawWrite creates an object at the API path, such as "dcim/cables",
+// when id is 0 and replaces the object with the given ID otherwise. The body
+// is sent as is, so fields that go-netbox would omit when empty can be
+// cleared by setting them to nil, "" or false. The response is decoded into
+// out.
+func netboxRawWrite(c *client.NetBox, path string, id int64, body interface{}, out interface{}) error {
+	method, pathPattern := "POST", "/"+path+"/"
+	if id != 0 {
+		method, pathPattern = "PUT", "/"+path+"/{id}/"
+	}
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		if id != 0 {
+			if err := r.SetPathParam("id", strconv.FormatInt(id, 10)); err != nil {
+				return err
+			}
+		}
+
+		return r.SetBodyParam(body)
+	}
+
+	return netboxRawOperation(c, strings.Replace(path, "/", "_", -1)+"_write", method, pathPattern, params, out)
+}
@@ -0,0 +1,94 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNetboxRawWrite(t *testing.T) {
+	cases := []struct {
+		name   string
+		id     int64
+		method string
+		path   string
+	}{
+		{
+			name:   "create",
+			id:     0,
+			method: "POST",
+			path:   "/api/dcim/power-port-templates/",
+		},
+		{
+			name:   "update",
+			id:     21,
+			method: "PUT",
+			path:   "/api/dcim/power-port-templates/21/",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tc.method || r.URL.Path != tc.path {
+					t.Errorf("request: got %s %s, want %s %s", r.Method, r.URL.Path, tc.method, tc.path)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+
+				// Empty values must reach Netbox to clear the fields.
+				expected := map[string]interface{}{
+					"name":         "PSU1",
+					"type":         "",
+					"maximum_draw": nil,
+				}
+
+				if !reflect.DeepEqual(body, expected) {
+					t.Errorf("body: got %#v, want %#v", body, expected)
+				}
+
+				fmt.Fprint(w, `{"id": 21, "name": "PSU1"}`)
+			})
+
+			var out struct {
+				ID int64 `json:"id"`
+			}
+
+			fields := map[string]interface{}{
+				"name":         "PSU1",
+				"type":         "",
+				"maximum_draw": nil,
+			}
+
+			if err := netboxRawWrite(meta.client, "dcim/power-port-templates", tc.id, fields, &out); err != nil {
+				t.Fatal(err)
+			}
+
+			if out.ID != 21 {
+				t.Errorf("id: got %d, want 21", out.ID)
+			}
+		})
+	}
+}
+
+func TestNetboxRawWriteError(t *testing.T) {
+	meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"name": ["This field is required."]}`)
+	})
+
+	err := netboxRawWrite(meta.client, "dcim/power-port-templates", 0, map[string]interface{}{}, nil)
+	if err == nil {
+		t.Fatal("expected an error for a rejected write")
+	}
+
+	if want := "dcim_power-port-templates_write (status 400)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error: got %q, want it to contain %q", err.Error(), want)
+	}
+}
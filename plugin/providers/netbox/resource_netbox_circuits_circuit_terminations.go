@@ -0,0 +1,208 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/circuits"
+)
+
+func resourceNetboxCircuitsCircuitTermination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitsCircuitTerminationCreate,
+		Read:   resourceNetboxCircuitsCircuitTerminationRead,
+		Update: resourceNetboxCircuitsCircuitTerminationUpdate,
+		Delete: resourceNetboxCircuitsCircuitTerminationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"circuit_termination_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"circuit_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"term_side": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A",
+					"Z",
+				}, false),
+			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"port_speed": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"upstream_speed": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"xconnect_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pp_info": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connection_status": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsCircuitTerminationFields(d *schema.ResourceData) map[string]interface{} {
+	upstreamSpeed := int64(d.Get("upstream_speed").(int))
+
+	return map[string]interface{}{
+		"circuit":        d.Get("circuit_id").(int),
+		"term_side":      d.Get("term_side").(string),
+		"site":           d.Get("site_id").(int),
+		"port_speed":     d.Get("port_speed").(int),
+		"upstream_speed": nilFromInt64Ptr(&upstreamSpeed),
+		"xconnect_id":    d.Get("xconnect_id").(string),
+		"pp_info":        d.Get("pp_info").(string),
+		"description":    d.Get("description").(string),
+	}
+}
+
+func resourceNetboxCircuitsCircuitTerminationCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxCircuitsCircuitTerminationFields(d)
+
+	log.Debugf("Executing CircuitsCircuitTerminationsCreate against Netbox: %v", fields)
+
+	// Written through netboxRawWrite since go-netbox can not decode the
+	// connected endpoint in the response.
+	var out circuitTerminationRecord
+
+	err := netboxRawWrite(netboxClient, "circuits/circuit-terminations", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTerminationsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("circuits/circuit-terminations/%d", out.ID))
+	d.Set("circuit_termination_id", out.ID)
+
+	log.Debugf("Done Executing CircuitsCircuitTerminationsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTerminationRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_termination_id").(int))
+
+	result, err := netboxCircuitTerminationRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Circuits CircuitTermination ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var circuitID int64
+	if result.Circuit != nil {
+		circuitID = result.Circuit.ID
+	}
+	d.Set("circuit_id", circuitID)
+
+	d.Set("term_side", result.TermSide)
+
+	var siteID int64
+	if result.Site != nil {
+		siteID = result.Site.ID
+	}
+	d.Set("site_id", siteID)
+
+	d.Set("port_speed", result.PortSpeed)
+	d.Set("upstream_speed", result.UpstreamSpeed)
+	d.Set("xconnect_id", result.XconnectID)
+	d.Set("pp_info", result.PpInfo)
+	d.Set("description", result.Description)
+
+	var cableID int64
+	if result.Cable != nil {
+		cableID = result.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	var connectionStatus bool
+	if result.ConnectionStatus != nil {
+		connectionStatus = result.ConnectionStatus.Value
+	}
+	d.Set("connection_status", connectionStatus)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTerminationUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_termination_id").(int))
+
+	fields := resourceNetboxCircuitsCircuitTerminationFields(d)
+
+	log.Debugf("Executing CircuitsCircuitTerminationsUpdate against Netbox: %v", fields)
+
+	var out circuitTerminationRecord
+
+	err := netboxRawWrite(netboxClient, "circuits/circuit-terminations", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTerminationsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitTerminationsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTerminationDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Circuits CircuitTermination: %v\n", d)
+
+	id := int64(d.Get("circuit_termination_id").(int))
+
+	var parm = circuits.NewCircuitsCircuitTerminationsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Circuits.CircuitsCircuitTerminationsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTerminationsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitTerminationsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/circuits"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxCircuitsCircuitType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitsCircuitTypeCreate,
+		Read:   resourceNetboxCircuitsCircuitTypeRead,
+		Update: resourceNetboxCircuitsCircuitTypeUpdate,
+		Delete: resourceNetboxCircuitsCircuitTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"circuit_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsCircuitTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = circuits.NewCircuitsCircuitTypesCreateParams().WithData(
+		&models.CircuitType{
+			Name:        &name,
+			Slug:        &slug,
+			Description: d.Get("description").(string),
+		},
+	)
+
+	log.Debugf("Executing CircuitsCircuitTypesCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Circuits.CircuitsCircuitTypesCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTypesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("circuits/circuit-types/%d", out.Payload.ID))
+	d.Set("circuit_type_id", out.Payload.ID)
+
+	log.Debugf("Done Executing CircuitsCircuitTypesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTypeRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_type_id").(int))
+
+	var parm = circuits.NewCircuitsCircuitTypesReadParams().WithID(id)
+
+	result, err := netboxClient.Circuits.CircuitsCircuitTypesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Circuits CircuitType ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+	d.Set("description", result.Payload.Description)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_type_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = circuits.NewCircuitsCircuitTypesUpdateParams().
+		WithID(id).
+		WithData(
+			&models.CircuitType{
+				Name:        &name,
+				Slug:        &slug,
+				Description: d.Get("description").(string),
+			},
+		)
+
+	log.Debugf("Executing CircuitsCircuitTypesUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Circuits.CircuitsCircuitTypesUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTypesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitTypesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Circuits CircuitType: %v\n", d)
+
+	id := int64(d.Get("circuit_type_id").(int))
+
+	var parm = circuits.NewCircuitsCircuitTypesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Circuits.CircuitsCircuitTypesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitTypesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitTypesDelete: %v", out)
+
+	return nil
+}
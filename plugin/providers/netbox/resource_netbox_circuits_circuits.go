@@ -0,0 +1,239 @@
+package netbox
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/circuits"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxCircuitsCircuit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitsCircuitCreate,
+		Read:   resourceNetboxCircuitsCircuitRead,
+		Update: resourceNetboxCircuitsCircuitUpdate,
+		Delete: resourceNetboxCircuitsCircuitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"circuit_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice([]string{
+					"planned",
+					"provisioning",
+					"active",
+					"offline",
+					"deprovisioning",
+					"decommissioned",
+				}, false),
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"install_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+					if _, err := time.Parse("2006-01-02", v.(string)); err != nil {
+						es = append(es, fmt.Errorf("%s must be a date in YYYY-MM-DD format: %v", k, err))
+					}
+					return
+				},
+			},
+			"commit_rate": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsCircuitFields(d *schema.ResourceData) map[string]interface{} {
+	tenantID := int64(d.Get("tenant_id").(int))
+	commitRate := int64(d.Get("commit_rate").(int))
+
+	// An empty install date is sent as null, Netbox rejects an empty string.
+	var installDate interface{}
+	if v := d.Get("install_date").(string); v != "" {
+		installDate = v
+	}
+
+	return map[string]interface{}{
+		"cid":          d.Get("cid").(string),
+		"provider":     d.Get("provider_id").(int),
+		"type":         d.Get("type_id").(int),
+		"status":       d.Get("status").(string),
+		"tenant":       nilFromInt64Ptr(&tenantID),
+		"install_date": installDate,
+		"commit_rate":  nilFromInt64Ptr(&commitRate),
+		"description":  d.Get("description").(string),
+		"comments":     d.Get("comments").(string),
+		"tags":         expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxCircuitsCircuitCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxCircuitsCircuitFields(d)
+
+	log.Debugf("Executing CircuitsCircuitsCreate against Netbox: %v", fields)
+
+	var out models.Circuit
+
+	err := netboxRawWrite(netboxClient, "circuits/circuits", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("circuits/circuits/%d", out.ID))
+	d.Set("circuit_id", out.ID)
+
+	log.Debugf("Done Executing CircuitsCircuitsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_id").(int))
+
+	var parm = circuits.NewCircuitsCircuitsReadParams().WithID(id)
+
+	result, err := netboxClient.Circuits.CircuitsCircuitsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Circuits Circuit ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("cid", result.Payload.Cid)
+
+	var providerID int64
+	if result.Payload.Provider != nil {
+		providerID = result.Payload.Provider.ID
+	}
+	d.Set("provider_id", providerID)
+
+	var typeID int64
+	if result.Payload.Type != nil {
+		typeID = result.Payload.Type.ID
+	}
+	d.Set("type_id", typeID)
+
+	var status string
+	if result.Payload.Status != nil {
+		status = *result.Payload.Status.Value
+	}
+	d.Set("status", status)
+
+	var tenantID int64
+	if result.Payload.Tenant != nil {
+		tenantID = result.Payload.Tenant.ID
+	}
+	d.Set("tenant_id", tenantID)
+
+	var installDate string
+	if result.Payload.InstallDate != nil {
+		installDate = result.Payload.InstallDate.String()
+	}
+	d.Set("install_date", installDate)
+
+	var commitRate int64
+	if result.Payload.CommitRate != nil {
+		commitRate = *result.Payload.CommitRate
+	}
+	d.Set("commit_rate", commitRate)
+
+	d.Set("description", result.Payload.Description)
+	d.Set("comments", result.Payload.Comments)
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("circuit_id").(int))
+
+	fields := resourceNetboxCircuitsCircuitFields(d)
+
+	log.Debugf("Executing CircuitsCircuitsUpdate against Netbox: %v", fields)
+
+	var out models.Circuit
+
+	err := netboxRawWrite(netboxClient, "circuits/circuits", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsCircuitDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Circuits Circuit: %v\n", d)
+
+	id := int64(d.Get("circuit_id").(int))
+
+	var parm = circuits.NewCircuitsCircuitsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Circuits.CircuitsCircuitsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsCircuitsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing CircuitsCircuitsDelete: %v", out)
+
+	return nil
+}
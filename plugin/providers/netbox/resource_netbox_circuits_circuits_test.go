@@ -0,0 +1,90 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Unset optional fields of circuits and circuit terminations must be sent
+// empty so that they are cleared in Netbox.
+func TestResourceNetboxCircuitsFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		fields   func(*schema.ResourceData) map[string]interface{}
+		config   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "circuit",
+			resource: resourceNetboxCircuitsCircuit(),
+			fields:   resourceNetboxCircuitsCircuitFields,
+			config:   map[string]interface{}{"cid": "CID-1", "provider_id": 2, "type_id": 4},
+			expected: map[string]interface{}{
+				"cid":          "CID-1",
+				"provider":     2,
+				"type":         4,
+				"status":       "active",
+				"tenant":       (*int64)(nil),
+				"install_date": nil,
+				"commit_rate":  (*int64)(nil),
+				"description":  "",
+				"comments":     "",
+				"tags":         []string{},
+			},
+		},
+		{
+			name:     "circuit with install date",
+			resource: resourceNetboxCircuitsCircuit(),
+			fields:   resourceNetboxCircuitsCircuitFields,
+			config: map[string]interface{}{
+				"cid":          "CID-1",
+				"provider_id":  2,
+				"type_id":      4,
+				"tenant_id":    6,
+				"install_date": "2020-04-25",
+				"commit_rate":  10000,
+			},
+			expected: map[string]interface{}{
+				"cid":          "CID-1",
+				"provider":     2,
+				"type":         4,
+				"status":       "active",
+				"tenant":       testInt64(6),
+				"install_date": "2020-04-25",
+				"commit_rate":  testInt64(10000),
+				"description":  "",
+				"comments":     "",
+				"tags":         []string{},
+			},
+		},
+		{
+			name:     "circuit termination",
+			resource: resourceNetboxCircuitsCircuitTermination(),
+			fields:   resourceNetboxCircuitsCircuitTerminationFields,
+			config:   map[string]interface{}{"circuit_id": 1, "term_side": "A", "site_id": 3, "port_speed": 1000},
+			expected: map[string]interface{}{
+				"circuit":        1,
+				"term_side":      "A",
+				"site":           3,
+				"port_speed":     1000,
+				"upstream_speed": (*int64)(nil),
+				"xconnect_id":    "",
+				"pp_info":        "",
+				"description":    "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, tc.config)
+
+			if actual := tc.fields(d); !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/circuits"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxCircuitsProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxCircuitsProviderCreate,
+		Read:   resourceNetboxCircuitsProviderRead,
+		Update: resourceNetboxCircuitsProviderUpdate,
+		Delete: resourceNetboxCircuitsProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"asn": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"account": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"portal_url": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"noc_contact": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_contact": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxCircuitsProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	asn := int64(d.Get("asn").(int))
+
+	var parm = circuits.NewCircuitsProvidersCreateParams().WithData(
+		&models.Provider{
+			Name:         &name,
+			Slug:         &slug,
+			Asn:          nilFromInt64Ptr(&asn),
+			Account:      d.Get("account").(string),
+			PortalURL:    strfmt.URI(d.Get("portal_url").(string)),
+			NocContact:   d.Get("noc_contact").(string),
+			AdminContact: d.Get("admin_contact").(string),
+			Comments:     d.Get("comments").(string),
+			Tags:         expandStringSet(d.Get("tags").(*schema.Set)),
+		},
+	)
+
+	log.Debugf("Executing CircuitsProvidersCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Circuits.CircuitsProvidersCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsProvidersCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("circuits/providers/%d", out.Payload.ID))
+	d.Set("provider_id", out.Payload.ID)
+
+	log.Debugf("Done Executing CircuitsProvidersCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("provider_id").(int))
+
+	var parm = circuits.NewCircuitsProvidersReadParams().WithID(id)
+
+	result, err := netboxClient.Circuits.CircuitsProvidersRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Circuits Provider ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	var asn int64
+	if result.Payload.Asn != nil {
+		asn = *result.Payload.Asn
+	}
+	d.Set("asn", asn)
+
+	d.Set("account", result.Payload.Account)
+	d.Set("portal_url", result.Payload.PortalURL.String())
+	d.Set("noc_contact", result.Payload.NocContact)
+	d.Set("admin_contact", result.Payload.AdminContact)
+	d.Set("comments", result.Payload.Comments)
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("provider_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+	asn := int64(d.Get("asn").(int))
+
+	var parm = circuits.NewCircuitsProvidersUpdateParams().
+		WithID(id).
+		WithData(
+			&models.Provider{
+				Name:         &name,
+				Slug:         &slug,
+				Asn:          nilFromInt64Ptr(&asn),
+				Account:      d.Get("account").(string),
+				PortalURL:    strfmt.URI(d.Get("portal_url").(string)),
+				NocContact:   d.Get("noc_contact").(string),
+				AdminContact: d.Get("admin_contact").(string),
+				Comments:     d.Get("comments").(string),
+				Tags:         expandStringSet(d.Get("tags").(*schema.Set)),
+			},
+		)
+
+	log.Debugf("Executing CircuitsProvidersUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Circuits.CircuitsProvidersUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsProvidersUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing CircuitsProvidersUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxCircuitsProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Circuits Provider: %v\n", d)
+
+	id := int64(d.Get("provider_id").(int))
+
+	var parm = circuits.NewCircuitsProvidersDeleteParams().WithID(id)
+
+	out, err := netboxClient.Circuits.CircuitsProvidersDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute CircuitsProvidersDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing CircuitsProvidersDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,243 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimCable() *schema.Resource {
+	terminationTypes := make([]string, 0, len(dcimCableTerminationCompatibility))
+	for terminationType := range dcimCableTerminationCompatibility {
+		terminationTypes = append(terminationTypes, terminationType)
+	}
+
+	return &schema.Resource{
+		Create:        resourceNetboxDcimCableCreate,
+		Read:          resourceNetboxDcimCableRead,
+		Update:        resourceNetboxDcimCableUpdate,
+		Delete:        resourceNetboxDcimCableDelete,
+		CustomizeDiff: resourceNetboxDcimCableCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Netbox refuses to move an existing cable, so any change to
+			// either end replaces the cable.
+			"termination_a_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(terminationTypes, false),
+			},
+			"termination_a_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"termination_b_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(terminationTypes, false),
+			},
+			"termination_b_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimCableTypeChoices, false),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "connected",
+				ValidateFunc: validation.StringInSlice([]string{
+					"connected",
+					"planned",
+					"decommissioning",
+				}, false),
+			},
+			"label": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"color": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{6}$"), "must be a lowercase hex RGB value, e.g. \"00ff00\""),
+			},
+			"length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"length_unit": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"m",
+					"cm",
+					"ft",
+					"in",
+				}, false),
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimCableCustomizeDiff rejects cables whose two ends can not
+// be connected to each other before the request ever reaches Netbox.
+func resourceNetboxDcimCableCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	aType := d.Get("termination_a_type").(string)
+	bType := d.Get("termination_b_type").(string)
+
+	if aType == "" || bType == "" {
+		return nil
+	}
+
+	for _, compatible := range dcimCableTerminationCompatibility[aType] {
+		if compatible == bType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Incompatible cable termination types: %s can not be connected to %s", aType, bType)
+}
+
+func resourceNetboxDcimCableFields(d *schema.ResourceData) map[string]interface{} {
+	length := int64(d.Get("length").(int))
+
+	return map[string]interface{}{
+		"termination_a_type": d.Get("termination_a_type").(string),
+		"termination_a_id":   d.Get("termination_a_id").(int),
+		"termination_b_type": d.Get("termination_b_type").(string),
+		"termination_b_id":   d.Get("termination_b_id").(int),
+		"type":               d.Get("type").(string),
+		"status":             d.Get("status").(string),
+		"label":              d.Get("label").(string),
+		"color":              d.Get("color").(string),
+		"length":             nilFromInt64Ptr(&length),
+		"length_unit":        d.Get("length_unit").(string),
+	}
+}
+
+func resourceNetboxDcimCableCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimCableFields(d)
+
+	log.Debugf("Executing DcimCablesCreate against Netbox: %v", fields)
+
+	// Written through netboxRawWrite since go-netbox can not decode the
+	// cable terminations in the response.
+	var out cableRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/cables", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimCablesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/cables/%d", out.ID))
+	d.Set("cable_id", out.ID)
+
+	log.Debugf("Done Executing DcimCablesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimCableRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cable_id").(int))
+
+	result, err := netboxCableRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim Cable ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("termination_a_type", result.TerminationAType)
+	d.Set("termination_a_id", result.TerminationAID)
+	d.Set("termination_b_type", result.TerminationBType)
+	d.Set("termination_b_id", result.TerminationBID)
+	d.Set("type", result.Type)
+
+	var status string
+	if result.Status != nil {
+		status = result.Status.Value
+	}
+	d.Set("status", status)
+
+	d.Set("label", result.Label)
+	d.Set("color", result.Color)
+	d.Set("length", result.Length)
+
+	var lengthUnit string
+	if result.LengthUnit != nil {
+		lengthUnit = result.LengthUnit.Value
+	}
+	d.Set("length_unit", lengthUnit)
+
+	return nil
+}
+
+func resourceNetboxDcimCableUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cable_id").(int))
+
+	fields := resourceNetboxDcimCableFields(d)
+
+	log.Debugf("Executing DcimCablesUpdate against Netbox: %v", fields)
+
+	var out cableRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/cables", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimCablesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimCablesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimCableDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim Cable: %v\n", d)
+
+	id := int64(d.Get("cable_id").(int))
+
+	var parm = dcim.NewDcimCablesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimCablesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimCablesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimCablesDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package netbox
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceNetboxDcimCableCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		aType   string
+		bType   string
+		wantErr bool
+	}{
+		{"dcim.interface", "dcim.interface", false},
+		{"dcim.interface", "circuits.circuittermination", false},
+		{"circuits.circuittermination", "dcim.rearport", false},
+		{"dcim.consoleport", "dcim.consoleserverport", false},
+		{"dcim.frontport", "dcim.consoleport", false},
+		{"dcim.powerport", "dcim.powerfeed", false},
+		{"dcim.poweroutlet", "dcim.powerport", false},
+		{"dcim.interface", "dcim.powerport", true},
+		{"dcim.consoleport", "dcim.consoleport", true},
+		{"dcim.powerfeed", "dcim.poweroutlet", true},
+		{"dcim.poweroutlet", "dcim.frontport", true},
+		{"circuits.circuittermination", "circuits.circuittermination", true},
+	}
+
+	r := resourceNetboxDcimCable()
+
+	for _, tc := range cases {
+		t.Run(tc.aType+"/"+tc.bType, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"termination_a_type": tc.aType,
+				"termination_a_id":   1,
+				"termination_b_type": tc.bType,
+				"termination_b_id":   2,
+			})
+
+			_, err := r.Diff(nil, config, nil)
+
+			if tc.wantErr && (err == nil || !strings.Contains(err.Error(), "Incompatible cable termination types")) {
+				t.Fatalf("expected %s to %s to be rejected, got: %v", tc.aType, tc.bType, err)
+			}
+
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected %s to %s to be accepted, got: %s", tc.aType, tc.bType, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimConsolePortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimConsolePortTemplateCreate,
+		Read:   resourceNetboxDcimConsolePortTemplateRead,
+		Update: resourceNetboxDcimConsolePortTemplateUpdate,
+		Delete: resourceNetboxDcimConsolePortTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"console_port_template_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimConsolePortTypeChoices, false),
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimConsolePortTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	name := d.Get("name").(string)
+
+	var parm = dcim.NewDcimConsolePortTemplatesCreateParams().WithData(
+		&models.WritableConsolePortTemplate{
+			DeviceType: &deviceTypeID,
+			Name:       &name,
+			Type:       d.Get("type").(string),
+		},
+	)
+
+	log.Debugf("Executing DcimConsolePortTemplatesCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimConsolePortTemplatesCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortTemplatesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/console-port-templates/%d", out.Payload.ID))
+	d.Set("console_port_template_id", out.Payload.ID)
+
+	log.Debugf("Done Executing DcimConsolePortTemplatesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("console_port_template_id").(int))
+
+	var parm = dcim.NewDcimConsolePortTemplatesReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimConsolePortTemplatesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim ConsolePortTemplate ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceTypeID int64
+	if result.Payload.DeviceType != nil {
+		deviceTypeID = result.Payload.DeviceType.ID
+	}
+	d.Set("device_type_id", deviceTypeID)
+
+	d.Set("name", result.Payload.Name)
+
+	var portType string
+	if result.Payload.Type != nil {
+		portType = *result.Payload.Type.Value
+	}
+	d.Set("type", portType)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("console_port_template_id").(int))
+
+	deviceTypeID := int64(d.Get("device_type_id").(int))
+	name := d.Get("name").(string)
+
+	var parm = dcim.NewDcimConsolePortTemplatesUpdateParams().
+		WithID(id).
+		WithData(
+			&models.WritableConsolePortTemplate{
+				DeviceType: &deviceTypeID,
+				Name:       &name,
+				Type:       d.Get("type").(string),
+			},
+		)
+
+	log.Debugf("Executing DcimConsolePortTemplatesUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimConsolePortTemplatesUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortTemplatesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimConsolePortTemplatesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim ConsolePortTemplate: %v\n", d)
+
+	id := int64(d.Get("console_port_template_id").(int))
+
+	var parm = dcim.NewDcimConsolePortTemplatesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimConsolePortTemplatesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortTemplatesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimConsolePortTemplatesDelete: %v", out)
+
+	return nil
+}
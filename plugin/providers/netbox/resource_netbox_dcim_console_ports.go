@@ -0,0 +1,184 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimConsolePort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimConsolePortCreate,
+		Read:   resourceNetboxDcimConsolePortRead,
+		Update: resourceNetboxDcimConsolePortUpdate,
+		Delete: resourceNetboxDcimConsolePortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"console_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimConsolePortTypeChoices, false),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connection_status": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimConsolePortFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimConsolePortCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimConsolePortFields(d)
+
+	log.Debugf("Executing DcimConsolePortsCreate against Netbox: %v", fields)
+
+	// Written through netboxRawWrite since go-netbox can not decode the
+	// connected endpoint in the response.
+	var out consolePortRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/console-ports", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/console-ports/%d", out.ID))
+	d.Set("console_port_id", out.ID)
+
+	log.Debugf("Done Executing DcimConsolePortsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("console_port_id").(int))
+
+	result, err := netboxConsolePortRead(netboxClient, "dcim/console-ports", id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim ConsolePort ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Device != nil {
+		deviceID = result.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Name)
+
+	var portType string
+	if result.Type != nil {
+		portType = result.Type.Value
+	}
+	d.Set("type", portType)
+
+	d.Set("description", result.Description)
+	d.Set("tags", result.Tags)
+
+	var cableID int64
+	if result.Cable != nil {
+		cableID = result.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	var connectionStatus bool
+	if result.ConnectionStatus != nil {
+		connectionStatus = result.ConnectionStatus.Value
+	}
+	d.Set("connection_status", connectionStatus)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("console_port_id").(int))
+
+	fields := resourceNetboxDcimConsolePortFields(d)
+
+	log.Debugf("Executing DcimConsolePortsUpdate against Netbox: %v", fields)
+
+	var out consolePortRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/console-ports", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimConsolePortsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimConsolePortDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim ConsolePort: %v\n", d)
+
+	id := int64(d.Get("console_port_id").(int))
+
+	var parm = dcim.NewDcimConsolePortsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimConsolePortsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimConsolePortsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimConsolePortsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceNetboxDcimConsolePortFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		fields   func(*schema.ResourceData) map[string]interface{}
+	}{
+		{
+			name:     "console port",
+			resource: resourceNetboxDcimConsolePort(),
+			fields:   resourceNetboxDcimConsolePortFields,
+		},
+		{
+			name:     "console server port",
+			resource: resourceNetboxDcimConsoleServerPort(),
+			fields:   resourceNetboxDcimConsoleServerPortFields,
+		},
+	}
+
+	// An unset type and description must be sent so that they are cleared.
+	expected := map[string]interface{}{
+		"device":      3,
+		"name":        "Console",
+		"type":        "",
+		"description": "",
+		"tags":        []string{},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{
+				"device_id": 3,
+				"name":      "Console",
+			})
+
+			if actual := tc.fields(d); !reflect.DeepEqual(actual, expected) {
+				t.Fatalf("expected %#v, got %#v", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimDeviceRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimDeviceRoleCreate,
+		Read:   resourceNetboxDcimDeviceRoleRead,
+		Update: resourceNetboxDcimDeviceRoleUpdate,
+		Delete: resourceNetboxDcimDeviceRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_role_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"color": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{6}$"), "must be a lowercase hex RGB value, e.g. \"00ff00\""),
+			},
+			"vm_role": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimDeviceRoleFields builds the device role to send. The
+// go-netbox model omits a false vm_role, which Netbox then defaults to true.
+func resourceNetboxDcimDeviceRoleFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"color":       d.Get("color").(string),
+		"vm_role":     d.Get("vm_role").(bool),
+		"description": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxDcimDeviceRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimDeviceRoleFields(d)
+
+	log.Debugf("Executing DcimDeviceRolesCreate against Netbox: %v", fields)
+
+	var out models.DeviceRole
+
+	err := netboxRawWrite(netboxClient, "dcim/device-roles", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceRolesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/device-roles/%d", out.ID))
+	d.Set("device_role_id", out.ID)
+
+	log.Debugf("Done Executing DcimDeviceRolesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceRoleRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("device_role_id").(int))
+
+	var parm = dcim.NewDcimDeviceRolesReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimDeviceRolesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim DeviceRole ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+	d.Set("color", result.Payload.Color)
+	d.Set("vm_role", result.Payload.VMRole)
+	d.Set("description", result.Payload.Description)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("device_role_id").(int))
+
+	fields := resourceNetboxDcimDeviceRoleFields(d)
+
+	log.Debugf("Executing DcimDeviceRolesUpdate against Netbox: %v", fields)
+
+	var out models.DeviceRole
+
+	err := netboxRawWrite(netboxClient, "dcim/device-roles", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceRolesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimDeviceRolesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim DeviceRole: %v\n", d)
+
+	id := int64(d.Get("device_role_id").(int))
+
+	var parm = dcim.NewDcimDeviceRolesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimDeviceRolesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceRolesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimDeviceRolesDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimDeviceType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimDeviceTypeCreate,
+		Read:   resourceNetboxDcimDeviceTypeRead,
+		Update: resourceNetboxDcimDeviceTypeUpdate,
+		Delete: resourceNetboxDcimDeviceTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manufacturer_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"model": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"part_number": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"u_height": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"is_full_depth": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"subdevice_role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"parent",
+					"child",
+				}, false),
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimDeviceTypeFields builds the device type to send. The
+// go-netbox model omits a false is_full_depth, which Netbox then defaults to
+// true.
+func resourceNetboxDcimDeviceTypeFields(d *schema.ResourceData) map[string]interface{} {
+	fields := map[string]interface{}{
+		"manufacturer":   d.Get("manufacturer_id").(int),
+		"model":          d.Get("model").(string),
+		"slug":           d.Get("slug").(string),
+		"part_number":    d.Get("part_number").(string),
+		"u_height":       d.Get("u_height").(int),
+		"is_full_depth":  d.Get("is_full_depth").(bool),
+		"subdevice_role": nil,
+		"comments":       d.Get("comments").(string),
+		"tags":           []string{},
+	}
+
+	if subdeviceRole := d.Get("subdevice_role").(string); subdeviceRole != "" {
+		fields["subdevice_role"] = subdeviceRole
+	}
+
+	return fields
+}
+
+func resourceNetboxDcimDeviceTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimDeviceTypeFields(d)
+
+	log.Debugf("Executing DcimDeviceTypesCreate against Netbox: %v", fields)
+
+	var out models.DeviceType
+
+	err := netboxRawWrite(netboxClient, "dcim/device-types", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceTypesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/device-types/%d", out.ID))
+	d.Set("device_type_id", out.ID)
+
+	log.Debugf("Done Executing DcimDeviceTypesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("device_type_id").(int))
+
+	var parm = dcim.NewDcimDeviceTypesReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimDeviceTypesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim DeviceType ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var manufacturerID int64
+	if result.Payload.Manufacturer != nil {
+		manufacturerID = result.Payload.Manufacturer.ID
+	}
+	d.Set("manufacturer_id", manufacturerID)
+
+	d.Set("model", result.Payload.Model)
+	d.Set("slug", result.Payload.Slug)
+	d.Set("part_number", result.Payload.PartNumber)
+	d.Set("u_height", result.Payload.UHeight)
+	d.Set("is_full_depth", result.Payload.IsFullDepth)
+
+	var subdeviceRole string
+	if result.Payload.SubdeviceRole != nil {
+		subdeviceRole = *result.Payload.SubdeviceRole.Value
+	}
+	d.Set("subdevice_role", subdeviceRole)
+
+	d.Set("comments", result.Payload.Comments)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("device_type_id").(int))
+
+	fields := resourceNetboxDcimDeviceTypeFields(d)
+
+	log.Debugf("Executing DcimDeviceTypesUpdate against Netbox: %v", fields)
+
+	var out models.DeviceType
+
+	err := netboxRawWrite(netboxClient, "dcim/device-types", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceTypesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimDeviceTypesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimDeviceTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim DeviceType: %v\n", d)
+
+	id := int64(d.Get("device_type_id").(int))
+
+	var parm = dcim.NewDcimDeviceTypesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimDeviceTypesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimDeviceTypesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimDeviceTypesDelete: %v", out)
+
+	return nil
+}
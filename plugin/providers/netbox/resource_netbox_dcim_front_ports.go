@@ -0,0 +1,194 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimFrontPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimFrontPortCreate,
+		Read:   resourceNetboxDcimFrontPortRead,
+		Update: resourceNetboxDcimFrontPortUpdate,
+		Delete: resourceNetboxDcimFrontPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"front_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dcimPortTypeChoices, false),
+			},
+			"rear_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rear_port_position": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimFrontPortFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"device":             d.Get("device_id").(int),
+		"name":               d.Get("name").(string),
+		"type":               d.Get("type").(string),
+		"rear_port":          d.Get("rear_port_id").(int),
+		"rear_port_position": d.Get("rear_port_position").(int),
+		"description":        d.Get("description").(string),
+		"tags":               expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimFrontPortCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimFrontPortFields(d)
+
+	log.Debugf("Executing DcimFrontPortsCreate against Netbox: %v", fields)
+
+	var out models.FrontPort
+
+	err := netboxRawWrite(netboxClient, "dcim/front-ports", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimFrontPortsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/front-ports/%d", out.ID))
+	d.Set("front_port_id", out.ID)
+
+	log.Debugf("Done Executing DcimFrontPortsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("front_port_id").(int))
+
+	var parm = dcim.NewDcimFrontPortsReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimFrontPortsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim FrontPort ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Payload.Device != nil {
+		deviceID = result.Payload.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Payload.Name)
+
+	var portType string
+	if result.Payload.Type != nil {
+		portType = *result.Payload.Type.Value
+	}
+	d.Set("type", portType)
+
+	var rearPortID int64
+	if result.Payload.RearPort != nil {
+		rearPortID = result.Payload.RearPort.ID
+	}
+	d.Set("rear_port_id", rearPortID)
+
+	d.Set("rear_port_position", result.Payload.RearPortPosition)
+	d.Set("description", result.Payload.Description)
+	d.Set("tags", result.Payload.Tags)
+
+	var cableID int64
+	if result.Payload.Cable != nil {
+		cableID = result.Payload.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("front_port_id").(int))
+
+	fields := resourceNetboxDcimFrontPortFields(d)
+
+	log.Debugf("Executing DcimFrontPortsUpdate against Netbox: %v", fields)
+
+	var out models.FrontPort
+
+	err := netboxRawWrite(netboxClient, "dcim/front-ports", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimFrontPortsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimFrontPortsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimFrontPortDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim FrontPort: %v\n", d)
+
+	id := int64(d.Get("front_port_id").(int))
+
+	var parm = dcim.NewDcimFrontPortsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimFrontPortsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimFrontPortsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimFrontPortsDelete: %v", out)
+
+	return nil
+}
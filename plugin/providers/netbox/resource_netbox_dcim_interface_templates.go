@@ -0,0 +1,161 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimInterfaceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimInterfaceTemplateCreate,
+		Read:   resourceNetboxDcimInterfaceTemplateRead,
+		Update: resourceNetboxDcimInterfaceTemplateUpdate,
+		Delete: resourceNetboxDcimInterfaceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"interface_template_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dcimInterfaceTypeChoices, false),
+			},
+			"mgmt_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimInterfaceTemplateFields builds the interface template to
+// send. The go-netbox model omits a false mgmt_only, which would leave the
+// flag set on an update.
+func resourceNetboxDcimInterfaceTemplateFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"device_type": d.Get("device_type_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"mgmt_only":   d.Get("mgmt_only").(bool),
+	}
+}
+
+func resourceNetboxDcimInterfaceTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimInterfaceTemplateFields(d)
+
+	log.Debugf("Executing DcimInterfaceTemplatesCreate against Netbox: %v", fields)
+
+	var out models.InterfaceTemplate
+
+	err := netboxRawWrite(netboxClient, "dcim/interface-templates", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfaceTemplatesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/interface-templates/%d", out.ID))
+	d.Set("interface_template_id", out.ID)
+
+	log.Debugf("Done Executing DcimInterfaceTemplatesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("interface_template_id").(int))
+
+	var parm = dcim.NewDcimInterfaceTemplatesReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimInterfaceTemplatesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim InterfaceTemplate ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceTypeID int64
+	if result.Payload.DeviceType != nil {
+		deviceTypeID = result.Payload.DeviceType.ID
+	}
+	d.Set("device_type_id", deviceTypeID)
+
+	d.Set("name", result.Payload.Name)
+
+	var ifaceType string
+	if result.Payload.Type != nil {
+		ifaceType = *result.Payload.Type.Value
+	}
+	d.Set("type", ifaceType)
+
+	d.Set("mgmt_only", result.Payload.MgmtOnly)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("interface_template_id").(int))
+
+	fields := resourceNetboxDcimInterfaceTemplateFields(d)
+
+	log.Debugf("Executing DcimInterfaceTemplatesUpdate against Netbox: %v", fields)
+
+	var out models.InterfaceTemplate
+
+	err := netboxRawWrite(netboxClient, "dcim/interface-templates", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfaceTemplatesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimInterfaceTemplatesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim InterfaceTemplate: %v\n", d)
+
+	id := int64(d.Get("interface_template_id").(int))
+
+	var parm = dcim.NewDcimInterfaceTemplatesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimInterfaceTemplatesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfaceTemplatesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimInterfaceTemplatesDelete: %v", out)
+
+	return nil
+}
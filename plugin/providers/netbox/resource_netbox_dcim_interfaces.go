@@ -0,0 +1,254 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimInterfaceCreate,
+		Read:   resourceNetboxDcimInterfaceRead,
+		Update: resourceNetboxDcimInterfaceUpdate,
+		Delete: resourceNetboxDcimInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"interface_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dcimInterfaceTypeChoices, false),
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"lag_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"mtu": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65536),
+			},
+			"mac_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mgmt_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"access",
+					"tagged",
+					"tagged-all",
+				}, false),
+			},
+			"untagged_vlan_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tagged_vlan_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimInterfaceFields builds the request body for a device
+// interface, sending null for an unset LAG, VLAN, MTU, MAC address or mode.
+func resourceNetboxDcimInterfaceFields(d *schema.ResourceData) map[string]interface{} {
+	deviceID := int64(d.Get("device_id").(int))
+	lagID := int64(d.Get("lag_id").(int))
+	mtu := int64(d.Get("mtu").(int))
+	macAddress := d.Get("mac_address").(string)
+	untaggedVlanID := int64(d.Get("untagged_vlan_id").(int))
+
+	fields := map[string]interface{}{
+		"device":        &deviceID,
+		"name":          d.Get("name").(string),
+		"type":          d.Get("type").(string),
+		"enabled":       d.Get("enabled").(bool),
+		"lag":           nilFromInt64Ptr(&lagID),
+		"mtu":           nilFromInt64Ptr(&mtu),
+		"mac_address":   nilFromStringPtr(&macAddress),
+		"mgmt_only":     d.Get("mgmt_only").(bool),
+		"mode":          nil,
+		"untagged_vlan": nilFromInt64Ptr(&untaggedVlanID),
+		"tagged_vlans":  expandInt64Set(d.Get("tagged_vlan_ids").(*schema.Set)),
+		"description":   d.Get("description").(string),
+		"tags":          expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+
+	if mode := d.Get("mode").(string); mode != "" {
+		fields["mode"] = mode
+	}
+
+	return fields
+}
+
+func resourceNetboxDcimInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimInterfaceFields(d)
+
+	log.Debugf("Executing DcimInterfacesCreate against Netbox: %v", fields)
+
+	var out interfaceRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/interfaces", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfacesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/interfaces/%d", out.ID))
+	d.Set("interface_id", out.ID)
+
+	log.Debugf("Done Executing DcimInterfacesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("interface_id").(int))
+
+	result, err := netboxInterfaceRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim Interface ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Device != nil {
+		deviceID = result.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Name)
+
+	var ifaceType string
+	if result.Type != nil {
+		ifaceType = result.Type.Value
+	}
+	d.Set("type", ifaceType)
+
+	d.Set("enabled", result.Enabled)
+
+	var lagID int64
+	if result.Lag != nil {
+		lagID = result.Lag.ID
+	}
+	d.Set("lag_id", lagID)
+
+	d.Set("mtu", result.Mtu)
+	d.Set("mac_address", result.MacAddress)
+	d.Set("mgmt_only", result.MgmtOnly)
+
+	var mode string
+	if result.Mode != nil {
+		mode = result.Mode.Value
+	}
+	d.Set("mode", mode)
+
+	var untaggedVlanID int64
+	if result.UntaggedVlan != nil {
+		untaggedVlanID = result.UntaggedVlan.ID
+	}
+	d.Set("untagged_vlan_id", untaggedVlanID)
+
+	taggedVlanIDs := make([]int64, 0, len(result.TaggedVlans))
+	for _, vlan := range result.TaggedVlans {
+		taggedVlanIDs = append(taggedVlanIDs, vlan.ID)
+	}
+	d.Set("tagged_vlan_ids", taggedVlanIDs)
+
+	d.Set("description", result.Description)
+	d.Set("tags", result.Tags)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("interface_id").(int))
+
+	fields := resourceNetboxDcimInterfaceFields(d)
+
+	log.Debugf("Executing DcimInterfacesUpdate against Netbox: %v", fields)
+
+	var out interfaceRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/interfaces", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfacesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimInterfacesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim Interface: %v\n", d)
+
+	id := int64(d.Get("interface_id").(int))
+
+	var parm = dcim.NewDcimInterfacesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimInterfacesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInterfacesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimInterfacesDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimInventoryItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimInventoryItemCreate,
+		Read:   resourceNetboxDcimInventoryItemRead,
+		Update: resourceNetboxDcimInventoryItemUpdate,
+		Delete: resourceNetboxDcimInventoryItemDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"inventory_item_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"manufacturer_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"part_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"serial": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"asset_tag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"discovered": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceNetboxDcimInventoryItemFields builds the request body for an
+// inventory item. Items without a parent_id are sent with a null parent,
+// which moves them back to the top level of the device, and a false
+// discovered is sent rather than omitted as by the go-netbox model.
+func resourceNetboxDcimInventoryItemFields(d *schema.ResourceData) map[string]interface{} {
+	deviceID := int64(d.Get("device_id").(int))
+	parentID := int64(d.Get("parent_id").(int))
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	assetTag := d.Get("asset_tag").(string)
+
+	return map[string]interface{}{
+		"device":       &deviceID,
+		"parent":       nilFromInt64Ptr(&parentID),
+		"name":         d.Get("name").(string),
+		"manufacturer": nilFromInt64Ptr(&manufacturerID),
+		"part_id":      d.Get("part_id").(string),
+		"serial":       d.Get("serial").(string),
+		"asset_tag":    nilFromStringPtr(&assetTag),
+		"discovered":   d.Get("discovered").(bool),
+		"description":  d.Get("description").(string),
+		"tags":         expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimInventoryItemCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimInventoryItemFields(d)
+
+	log.Debugf("Executing DcimInventoryItemsCreate against Netbox: %v", fields)
+
+	var out models.InventoryItem
+
+	err := netboxRawWrite(netboxClient, "dcim/inventory-items", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInventoryItemsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/inventory-items/%d", out.ID))
+	d.Set("inventory_item_id", out.ID)
+
+	log.Debugf("Done Executing DcimInventoryItemsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("inventory_item_id").(int))
+
+	var parm = dcim.NewDcimInventoryItemsReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimInventoryItemsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim InventoryItem ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Payload.Device != nil {
+		deviceID = result.Payload.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	var parentID int64
+	if result.Payload.Parent != nil {
+		parentID = *result.Payload.Parent
+	}
+	d.Set("parent_id", parentID)
+
+	d.Set("name", result.Payload.Name)
+
+	var manufacturerID int64
+	if result.Payload.Manufacturer != nil {
+		manufacturerID = result.Payload.Manufacturer.ID
+	}
+	d.Set("manufacturer_id", manufacturerID)
+
+	d.Set("part_id", result.Payload.PartID)
+	d.Set("serial", result.Payload.Serial)
+	d.Set("asset_tag", result.Payload.AssetTag)
+	d.Set("discovered", result.Payload.Discovered)
+	d.Set("description", result.Payload.Description)
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("inventory_item_id").(int))
+
+	fields := resourceNetboxDcimInventoryItemFields(d)
+
+	log.Debugf("Executing DcimInventoryItemsUpdate against Netbox: %v", fields)
+
+	var out models.InventoryItem
+
+	err := netboxRawWrite(netboxClient, "dcim/inventory-items", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInventoryItemsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimInventoryItemsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimInventoryItemDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim InventoryItem: %v\n", d)
+
+	id := int64(d.Get("inventory_item_id").(int))
+
+	var parm = dcim.NewDcimInventoryItemsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimInventoryItemsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimInventoryItemsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimInventoryItemsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimManufacturer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimManufacturerCreate,
+		Read:   resourceNetboxDcimManufacturerRead,
+		Update: resourceNetboxDcimManufacturerUpdate,
+		Delete: resourceNetboxDcimManufacturerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manufacturer_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimManufacturerCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = dcim.NewDcimManufacturersCreateParams().WithData(
+		&models.Manufacturer{
+			Name: &name,
+			Slug: &slug,
+		},
+	)
+
+	log.Debugf("Executing DcimManufacturersCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimManufacturersCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimManufacturersCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/manufacturers/%d", out.Payload.ID))
+	d.Set("manufacturer_id", out.Payload.ID)
+
+	log.Debugf("Done Executing DcimManufacturersCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimManufacturerRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("manufacturer_id").(int))
+
+	var parm = dcim.NewDcimManufacturersReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimManufacturersRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim Manufacturer ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	return nil
+}
+
+func resourceNetboxDcimManufacturerUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("manufacturer_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = dcim.NewDcimManufacturersUpdateParams().
+		WithID(id).
+		WithData(
+			&models.Manufacturer{
+				Name: &name,
+				Slug: &slug,
+			},
+		)
+
+	log.Debugf("Executing DcimManufacturersUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimManufacturersUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimManufacturersUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimManufacturersUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimManufacturerDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim Manufacturer: %v\n", d)
+
+	id := int64(d.Get("manufacturer_id").(int))
+
+	var parm = dcim.NewDcimManufacturersDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimManufacturersDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimManufacturersDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimManufacturersDelete: %v", out)
+
+	return nil
+}
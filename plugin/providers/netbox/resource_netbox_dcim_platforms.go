@@ -0,0 +1,178 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimPlatform() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPlatformCreate,
+		Read:   resourceNetboxDcimPlatformRead,
+		Update: resourceNetboxDcimPlatformUpdate,
+		Delete: resourceNetboxDcimPlatformDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"platform_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"manufacturer_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"napalm_driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"napalm_args": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPlatformFields(d *schema.ResourceData) map[string]interface{} {
+	fields := map[string]interface{}{
+		"name":          d.Get("name").(string),
+		"slug":          d.Get("slug").(string),
+		"manufacturer":  nil,
+		"napalm_driver": d.Get("napalm_driver").(string),
+		"napalm_args":   nil,
+	}
+
+	if manufacturerID := d.Get("manufacturer_id").(int); manufacturerID != 0 {
+		fields["manufacturer"] = manufacturerID
+	}
+
+	if napalmArgs := d.Get("napalm_args").(string); napalmArgs != "" {
+		fields["napalm_args"] = json.RawMessage(napalmArgs)
+	}
+
+	return fields
+}
+
+func resourceNetboxDcimPlatformCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimPlatformFields(d)
+
+	log.Debugf("Executing DcimPlatformsCreate against Netbox: %v", fields)
+
+	var out platformRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/platforms", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPlatformsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/platforms/%d", out.ID))
+	d.Set("platform_id", out.ID)
+
+	log.Debugf("Done Executing DcimPlatformsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("platform_id").(int))
+
+	result, err := netboxPlatformRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim Platform ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+
+	var manufacturerID int64
+	if result.Manufacturer != nil {
+		manufacturerID = result.Manufacturer.ID
+	}
+	d.Set("manufacturer_id", manufacturerID)
+
+	d.Set("napalm_driver", result.NapalmDriver)
+
+	var napalmArgs string
+	if len(result.NapalmArgs) > 0 && string(result.NapalmArgs) != "null" {
+		napalmArgs, err = structure.NormalizeJsonString(string(result.NapalmArgs))
+
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("napalm_args", napalmArgs)
+
+	return nil
+}
+
+func resourceNetboxDcimPlatformUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("platform_id").(int))
+
+	fields := resourceNetboxDcimPlatformFields(d)
+
+	log.Debugf("Executing DcimPlatformsUpdate against Netbox: %v", fields)
+
+	var out platformRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/platforms", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPlatformsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPlatformsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPlatformDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim Platform: %v\n", d)
+
+	id := int64(d.Get("platform_id").(int))
+
+	var parm = dcim.NewDcimPlatformsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPlatformsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPlatformsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPlatformsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPowerFeedCreate,
+		Read:   resourceNetboxDcimPowerFeedRead,
+		Update: resourceNetboxDcimPowerFeedUpdate,
+		Delete: resourceNetboxDcimPowerFeedDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_feed_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"power_panel_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rack_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: validation.StringInSlice([]string{
+					"offline",
+					"active",
+					"planned",
+					"failed",
+				}, false),
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "primary",
+				ValidateFunc: validation.StringInSlice([]string{
+					"primary",
+					"redundant",
+				}, false),
+			},
+			"supply": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ac",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ac",
+					"dc",
+				}, false),
+			},
+			"phase": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "single-phase",
+				ValidateFunc: validation.StringInSlice([]string{
+					"single-phase",
+					"three-phase",
+				}, false),
+			},
+			"voltage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"amperage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"max_utilization": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      80,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPowerFeedFields(d *schema.ResourceData) map[string]interface{} {
+	rackID := int64(d.Get("rack_id").(int))
+
+	return map[string]interface{}{
+		"power_panel":     d.Get("power_panel_id").(int),
+		"rack":            nilFromInt64Ptr(&rackID),
+		"name":            d.Get("name").(string),
+		"status":          d.Get("status").(string),
+		"type":            d.Get("type").(string),
+		"supply":          d.Get("supply").(string),
+		"phase":           d.Get("phase").(string),
+		"voltage":         d.Get("voltage").(int),
+		"amperage":        d.Get("amperage").(int),
+		"max_utilization": d.Get("max_utilization").(int),
+		"comments":        d.Get("comments").(string),
+		"tags":            expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimPowerFeedCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimPowerFeedFields(d)
+
+	log.Debugf("Executing DcimPowerFeedsCreate against Netbox: %v", fields)
+
+	var out models.PowerFeed
+
+	err := netboxRawWrite(netboxClient, "dcim/power-feeds", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerFeedsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/power-feeds/%d", out.ID))
+	d.Set("power_feed_id", out.ID)
+
+	log.Debugf("Done Executing DcimPowerFeedsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerFeedRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_feed_id").(int))
+
+	var parm = dcim.NewDcimPowerFeedsReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimPowerFeedsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim PowerFeed ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var powerPanelID int64
+	if result.Payload.PowerPanel != nil {
+		powerPanelID = result.Payload.PowerPanel.ID
+	}
+	d.Set("power_panel_id", powerPanelID)
+
+	var rackID int64
+	if result.Payload.Rack != nil {
+		rackID = result.Payload.Rack.ID
+	}
+	d.Set("rack_id", rackID)
+
+	d.Set("name", result.Payload.Name)
+
+	var status string
+	if result.Payload.Status != nil {
+		status = *result.Payload.Status.Value
+	}
+	d.Set("status", status)
+
+	var feedType string
+	if result.Payload.Type != nil {
+		feedType = *result.Payload.Type.Value
+	}
+	d.Set("type", feedType)
+
+	var supply string
+	if result.Payload.Supply != nil {
+		supply = *result.Payload.Supply.Value
+	}
+	d.Set("supply", supply)
+
+	var phase string
+	if result.Payload.Phase != nil {
+		phase = *result.Payload.Phase.Value
+	}
+	d.Set("phase", phase)
+
+	d.Set("voltage", result.Payload.Voltage)
+	d.Set("amperage", result.Payload.Amperage)
+	d.Set("max_utilization", result.Payload.MaxUtilization)
+	d.Set("comments", result.Payload.Comments)
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerFeedUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_feed_id").(int))
+
+	fields := resourceNetboxDcimPowerFeedFields(d)
+
+	log.Debugf("Executing DcimPowerFeedsUpdate against Netbox: %v", fields)
+
+	var out models.PowerFeed
+
+	err := netboxRawWrite(netboxClient, "dcim/power-feeds", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerFeedsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPowerFeedsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerFeedDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim PowerFeed: %v\n", d)
+
+	id := int64(d.Get("power_feed_id").(int))
+
+	var parm = dcim.NewDcimPowerFeedsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPowerFeedsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerFeedsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPowerFeedsDelete: %v", out)
+
+	return nil
+}
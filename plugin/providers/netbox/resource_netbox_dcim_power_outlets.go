@@ -0,0 +1,213 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimPowerOutlet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPowerOutletCreate,
+		Read:   resourceNetboxDcimPowerOutletRead,
+		Update: resourceNetboxDcimPowerOutletUpdate,
+		Delete: resourceNetboxDcimPowerOutletDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_outlet_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimPowerOutletTypeChoices, false),
+			},
+			"power_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"feed_leg": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A",
+					"B",
+					"C",
+				}, false),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connection_status": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPowerOutletFields(d *schema.ResourceData) map[string]interface{} {
+	powerPortID := int64(d.Get("power_port_id").(int))
+
+	return map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"power_port":  nilFromInt64Ptr(&powerPortID),
+		"feed_leg":    d.Get("feed_leg").(string),
+		"description": d.Get("description").(string),
+		"tags":        expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimPowerOutletCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimPowerOutletFields(d)
+
+	log.Debugf("Executing DcimPowerOutletsCreate against Netbox: %v", fields)
+
+	// Written through netboxRawWrite since go-netbox can not decode
+	// the connected endpoint in the response.
+	var out powerOutletRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/power-outlets", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerOutletsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/power-outlets/%d", out.ID))
+	d.Set("power_outlet_id", out.ID)
+
+	log.Debugf("Done Executing DcimPowerOutletsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerOutletRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_outlet_id").(int))
+
+	result, err := netboxPowerOutletRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim PowerOutlet ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Device != nil {
+		deviceID = result.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Name)
+
+	var portType string
+	if result.Type != nil {
+		portType = result.Type.Value
+	}
+	d.Set("type", portType)
+
+	var powerPortID int64
+	if result.PowerPort != nil {
+		powerPortID = result.PowerPort.ID
+	}
+	d.Set("power_port_id", powerPortID)
+
+	var feedLeg string
+	if result.FeedLeg != nil {
+		feedLeg = result.FeedLeg.Value
+	}
+	d.Set("feed_leg", feedLeg)
+
+	d.Set("description", result.Description)
+	d.Set("tags", result.Tags)
+
+	var cableID int64
+	if result.Cable != nil {
+		cableID = result.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	var connectionStatus bool
+	if result.ConnectionStatus != nil {
+		connectionStatus = result.ConnectionStatus.Value
+	}
+	d.Set("connection_status", connectionStatus)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerOutletUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_outlet_id").(int))
+
+	fields := resourceNetboxDcimPowerOutletFields(d)
+
+	log.Debugf("Executing DcimPowerOutletsUpdate against Netbox: %v", fields)
+
+	var out powerOutletRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/power-outlets", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerOutletsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPowerOutletsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerOutletDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim PowerOutlet: %v\n", d)
+
+	id := int64(d.Get("power_outlet_id").(int))
+
+	var parm = dcim.NewDcimPowerOutletsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPowerOutletsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerOutletsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPowerOutletsDelete: %v", out)
+
+	return nil
+}
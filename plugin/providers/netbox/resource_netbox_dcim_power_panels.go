@@ -0,0 +1,159 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimPowerPanel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPowerPanelCreate,
+		Read:   resourceNetboxDcimPowerPanelRead,
+		Update: resourceNetboxDcimPowerPanelUpdate,
+		Delete: resourceNetboxDcimPowerPanelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_panel_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"rack_group_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPowerPanelCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	siteID := int64(d.Get("site_id").(int))
+	rackGroupID := int64(d.Get("rack_group_id").(int))
+	name := d.Get("name").(string)
+
+	var parm = dcim.NewDcimPowerPanelsCreateParams().WithData(
+		&models.WritablePowerPanel{
+			Site:      &siteID,
+			RackGroup: nilFromInt64Ptr(&rackGroupID),
+			Name:      &name,
+		},
+	)
+
+	log.Debugf("Executing DcimPowerPanelsCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimPowerPanelsCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPanelsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/power-panels/%d", out.Payload.ID))
+	d.Set("power_panel_id", out.Payload.ID)
+
+	log.Debugf("Done Executing DcimPowerPanelsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPanelRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_panel_id").(int))
+
+	var parm = dcim.NewDcimPowerPanelsReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimPowerPanelsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim PowerPanel ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var siteID int64
+	if result.Payload.Site != nil {
+		siteID = result.Payload.Site.ID
+	}
+	d.Set("site_id", siteID)
+
+	var rackGroupID int64
+	if result.Payload.RackGroup != nil {
+		rackGroupID = result.Payload.RackGroup.ID
+	}
+	d.Set("rack_group_id", rackGroupID)
+
+	d.Set("name", result.Payload.Name)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPanelUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_panel_id").(int))
+
+	siteID := int64(d.Get("site_id").(int))
+	rackGroupID := int64(d.Get("rack_group_id").(int))
+	name := d.Get("name").(string)
+
+	var parm = dcim.NewDcimPowerPanelsUpdateParams().
+		WithID(id).
+		WithData(
+			&models.WritablePowerPanel{
+				Site:      &siteID,
+				RackGroup: nilFromInt64Ptr(&rackGroupID),
+				Name:      &name,
+			},
+		)
+
+	log.Debugf("Executing DcimPowerPanelsUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimPowerPanelsUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPanelsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPowerPanelsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPanelDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim PowerPanel: %v\n", d)
+
+	id := int64(d.Get("power_panel_id").(int))
+
+	var parm = dcim.NewDcimPowerPanelsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPowerPanelsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPanelsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPowerPanelsDelete: %v", out)
+
+	return nil
+}
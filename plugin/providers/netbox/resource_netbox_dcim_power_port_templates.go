@@ -0,0 +1,169 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimPowerPortTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPowerPortTemplateCreate,
+		Read:   resourceNetboxDcimPowerPortTemplateRead,
+		Update: resourceNetboxDcimPowerPortTemplateUpdate,
+		Delete: resourceNetboxDcimPowerPortTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_port_template_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimPowerPortTypeChoices, false),
+			},
+			"maximum_draw": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"allocated_draw": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPowerPortTemplateFields(d *schema.ResourceData) map[string]interface{} {
+	maximumDraw := int64(d.Get("maximum_draw").(int))
+	allocatedDraw := int64(d.Get("allocated_draw").(int))
+
+	return map[string]interface{}{
+		"device_type":    d.Get("device_type_id").(int),
+		"name":           d.Get("name").(string),
+		"type":           d.Get("type").(string),
+		"maximum_draw":   nilFromInt64Ptr(&maximumDraw),
+		"allocated_draw": nilFromInt64Ptr(&allocatedDraw),
+	}
+}
+
+func resourceNetboxDcimPowerPortTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimPowerPortTemplateFields(d)
+
+	log.Debugf("Executing DcimPowerPortTemplatesCreate against Netbox: %v", fields)
+
+	var out models.PowerPortTemplate
+
+	err := netboxRawWrite(netboxClient, "dcim/power-port-templates", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortTemplatesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/power-port-templates/%d", out.ID))
+	d.Set("power_port_template_id", out.ID)
+
+	log.Debugf("Done Executing DcimPowerPortTemplatesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_port_template_id").(int))
+
+	var parm = dcim.NewDcimPowerPortTemplatesReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimPowerPortTemplatesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim PowerPortTemplate ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceTypeID int64
+	if result.Payload.DeviceType != nil {
+		deviceTypeID = result.Payload.DeviceType.ID
+	}
+	d.Set("device_type_id", deviceTypeID)
+
+	d.Set("name", result.Payload.Name)
+
+	var portType string
+	if result.Payload.Type != nil {
+		portType = *result.Payload.Type.Value
+	}
+	d.Set("type", portType)
+
+	d.Set("maximum_draw", result.Payload.MaximumDraw)
+	d.Set("allocated_draw", result.Payload.AllocatedDraw)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_port_template_id").(int))
+
+	fields := resourceNetboxDcimPowerPortTemplateFields(d)
+
+	log.Debugf("Executing DcimPowerPortTemplatesUpdate against Netbox: %v", fields)
+
+	var out models.PowerPortTemplate
+
+	err := netboxRawWrite(netboxClient, "dcim/power-port-templates", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortTemplatesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPowerPortTemplatesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim PowerPortTemplate: %v\n", d)
+
+	id := int64(d.Get("power_port_template_id").(int))
+
+	var parm = dcim.NewDcimPowerPortTemplatesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPowerPortTemplatesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortTemplatesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPowerPortTemplatesDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceNetboxDcimPowerPortTemplateFields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxDcimPowerPortTemplate().Schema, map[string]interface{}{
+		"device_type_id": 4,
+		"name":           "PSU1",
+	})
+
+	expected := map[string]interface{}{
+		"device_type":    4,
+		"name":           "PSU1",
+		"type":           "",
+		"maximum_draw":   (*int64)(nil),
+		"allocated_draw": (*int64)(nil),
+	}
+
+	if actual := resourceNetboxDcimPowerPortTemplateFields(d); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,201 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func resourceNetboxDcimPowerPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimPowerPortCreate,
+		Read:   resourceNetboxDcimPowerPortRead,
+		Update: resourceNetboxDcimPowerPortUpdate,
+		Delete: resourceNetboxDcimPowerPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dcimPowerPortTypeChoices, false),
+			},
+			"maximum_draw": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"allocated_draw": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 32767),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connection_status": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimPowerPortFields(d *schema.ResourceData) map[string]interface{} {
+	maximumDraw := int64(d.Get("maximum_draw").(int))
+	allocatedDraw := int64(d.Get("allocated_draw").(int))
+
+	return map[string]interface{}{
+		"device":         d.Get("device_id").(int),
+		"name":           d.Get("name").(string),
+		"type":           d.Get("type").(string),
+		"maximum_draw":   nilFromInt64Ptr(&maximumDraw),
+		"allocated_draw": nilFromInt64Ptr(&allocatedDraw),
+		"description":    d.Get("description").(string),
+		"tags":           expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimPowerPortCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimPowerPortFields(d)
+
+	log.Debugf("Executing DcimPowerPortsCreate against Netbox: %v", fields)
+
+	// Written through netboxRawWrite since go-netbox can not decode the
+	// connected endpoint in the response.
+	var out powerPortRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/power-ports", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/power-ports/%d", out.ID))
+	d.Set("power_port_id", out.ID)
+
+	log.Debugf("Done Executing DcimPowerPortsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_port_id").(int))
+
+	result, err := netboxPowerPortRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim PowerPort ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Device != nil {
+		deviceID = result.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Name)
+
+	var portType string
+	if result.Type != nil {
+		portType = result.Type.Value
+	}
+	d.Set("type", portType)
+
+	d.Set("maximum_draw", result.MaximumDraw)
+	d.Set("allocated_draw", result.AllocatedDraw)
+	d.Set("description", result.Description)
+	d.Set("tags", result.Tags)
+
+	var cableID int64
+	if result.Cable != nil {
+		cableID = result.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	var connectionStatus bool
+	if result.ConnectionStatus != nil {
+		connectionStatus = result.ConnectionStatus.Value
+	}
+	d.Set("connection_status", connectionStatus)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("power_port_id").(int))
+
+	fields := resourceNetboxDcimPowerPortFields(d)
+
+	log.Debugf("Executing DcimPowerPortsUpdate against Netbox: %v", fields)
+
+	var out powerPortRecord
+
+	err := netboxRawWrite(netboxClient, "dcim/power-ports", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimPowerPortsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimPowerPortDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim PowerPort: %v\n", d)
+
+	id := int64(d.Get("power_port_id").(int))
+
+	var parm = dcim.NewDcimPowerPortsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimPowerPortsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimPowerPortsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimPowerPortsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Unset optional fields of power ports, outlets and feeds must be sent empty
+// so that they are cleared in Netbox.
+func TestResourceNetboxDcimPowerFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		fields   func(*schema.ResourceData) map[string]interface{}
+		config   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "power port",
+			resource: resourceNetboxDcimPowerPort(),
+			fields:   resourceNetboxDcimPowerPortFields,
+			config:   map[string]interface{}{"device_id": 3, "name": "PSU1"},
+			expected: map[string]interface{}{
+				"device":         3,
+				"name":           "PSU1",
+				"type":           "",
+				"maximum_draw":   (*int64)(nil),
+				"allocated_draw": (*int64)(nil),
+				"description":    "",
+				"tags":           []string{},
+			},
+		},
+		{
+			name:     "power outlet",
+			resource: resourceNetboxDcimPowerOutlet(),
+			fields:   resourceNetboxDcimPowerOutletFields,
+			config:   map[string]interface{}{"device_id": 3, "name": "Outlet 1"},
+			expected: map[string]interface{}{
+				"device":      3,
+				"name":        "Outlet 1",
+				"type":        "",
+				"power_port":  (*int64)(nil),
+				"feed_leg":    "",
+				"description": "",
+				"tags":        []string{},
+			},
+		},
+		{
+			name:     "power feed",
+			resource: resourceNetboxDcimPowerFeed(),
+			fields:   resourceNetboxDcimPowerFeedFields,
+			config:   map[string]interface{}{"power_panel_id": 8, "name": "Feed A"},
+			expected: map[string]interface{}{
+				"power_panel":     8,
+				"rack":            (*int64)(nil),
+				"name":            "Feed A",
+				"status":          "active",
+				"type":            "primary",
+				"supply":          "ac",
+				"phase":           "single-phase",
+				"voltage":         120,
+				"amperage":        20,
+				"max_utilization": 80,
+				"comments":        "",
+				"tags":            []string{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, tc.config)
+
+			if actual := tc.fields(d); !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
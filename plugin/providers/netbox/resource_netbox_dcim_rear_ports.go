@@ -0,0 +1,183 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimRearPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimRearPortCreate,
+		Read:   resourceNetboxDcimRearPortRead,
+		Update: resourceNetboxDcimRearPortUpdate,
+		Delete: resourceNetboxDcimRearPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rear_port_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dcimPortTypeChoices, false),
+			},
+			"positions": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cable_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxDcimRearPortFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"positions":   d.Get("positions").(int),
+		"description": d.Get("description").(string),
+		"tags":        expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxDcimRearPortCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxDcimRearPortFields(d)
+
+	log.Debugf("Executing DcimRearPortsCreate against Netbox: %v", fields)
+
+	var out models.RearPort
+
+	err := netboxRawWrite(netboxClient, "dcim/rear-ports", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimRearPortsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/rear-ports/%d", out.ID))
+	d.Set("rear_port_id", out.ID)
+
+	log.Debugf("Done Executing DcimRearPortsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimRearPortRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("rear_port_id").(int))
+
+	var parm = dcim.NewDcimRearPortsReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimRearPortsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim RearPort ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Payload.Device != nil {
+		deviceID = result.Payload.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	d.Set("name", result.Payload.Name)
+
+	var portType string
+	if result.Payload.Type != nil {
+		portType = *result.Payload.Type.Value
+	}
+	d.Set("type", portType)
+
+	d.Set("positions", result.Payload.Positions)
+	d.Set("description", result.Payload.Description)
+	d.Set("tags", result.Payload.Tags)
+
+	var cableID int64
+	if result.Payload.Cable != nil {
+		cableID = result.Payload.Cable.ID
+	}
+	d.Set("cable_id", cableID)
+
+	return nil
+}
+
+func resourceNetboxDcimRearPortUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("rear_port_id").(int))
+
+	fields := resourceNetboxDcimRearPortFields(d)
+
+	log.Debugf("Executing DcimRearPortsUpdate against Netbox: %v", fields)
+
+	var out models.RearPort
+
+	err := netboxRawWrite(netboxClient, "dcim/rear-ports", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimRearPortsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimRearPortsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimRearPortDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim RearPort: %v\n", d)
+
+	id := int64(d.Get("rear_port_id").(int))
+
+	var parm = dcim.NewDcimRearPortsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimRearPortsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimRearPortsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimRearPortsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,394 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxDcimVirtualChassis() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxDcimVirtualChassisCreate,
+		Read:   resourceNetboxDcimVirtualChassisRead,
+		Update: resourceNetboxDcimVirtualChassisUpdate,
+		Delete: resourceNetboxDcimVirtualChassisDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_chassis_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"master_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Members are read back from the devices themselves, so a
+			// device removed from the stack in Netbox shows up as drift.
+			"member": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"vc_position": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+						"vc_priority": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualChassisMembers(d *schema.ResourceData) []virtualChassisMember {
+	set := d.Get("member").(*schema.Set)
+	members := make([]virtualChassisMember, 0, set.Len())
+
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+
+		vcPosition := int64(m["vc_position"].(int))
+		vcPriority := int64(m["vc_priority"].(int))
+
+		members = append(members, virtualChassisMember{
+			DeviceID:   int64(m["device_id"].(int)),
+			VcPosition: &vcPosition,
+			VcPriority: nilFromInt64Ptr(&vcPriority),
+		})
+	}
+
+	return members
+}
+
+func validateVirtualChassisMaster(masterID int64, members []virtualChassisMember) error {
+	for _, m := range members {
+		if m.DeviceID == masterID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Virtual chassis master %d must also be listed as a member", masterID)
+}
+
+func int64PtrEqual(a *int64, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// applyVirtualChassisMembers moves the member devices of a virtual chassis
+// from the current to the desired state. Netbox has no way to update several
+// devices in one request, so if any device fails to update, every device
+// touched so far is restored before the error is returned. On success, the
+// returned function undoes the change for callers whose next step fails.
+func applyVirtualChassisMembers(c *client.NetBox, virtualChassisID int64, current []virtualChassisMember, desired []virtualChassisMember) (func(), error) {
+	currentByID := make(map[int64]virtualChassisMember)
+	for _, m := range current {
+		currentByID[m.DeviceID] = m
+	}
+
+	desiredByID := make(map[int64]virtualChassisMember)
+	for _, m := range desired {
+		desiredByID[m.DeviceID] = m
+	}
+
+	touched := make([]int64, 0)
+
+	rollback := func() {
+		// Release every position first so that restoring the original
+		// positions can not collide with the ones just assigned.
+		for _, id := range touched {
+			if err := netboxDevicePatch(c, id, map[string]interface{}{"vc_position": nil}); err != nil {
+				log.Errorf("Failed to release virtual chassis position of device %d: %v", id, err)
+			}
+		}
+
+		for i := len(touched) - 1; i >= 0; i-- {
+			id := touched[i]
+
+			fields := map[string]interface{}{
+				"virtual_chassis": nil,
+				"vc_position":     nil,
+				"vc_priority":     nil,
+			}
+
+			if original, ok := currentByID[id]; ok {
+				fields["virtual_chassis"] = virtualChassisID
+				fields["vc_position"] = original.VcPosition
+				fields["vc_priority"] = original.VcPriority
+			}
+
+			if err := netboxDevicePatch(c, id, fields); err != nil {
+				log.Errorf("Failed to restore virtual chassis membership of device %d: %v", id, err)
+			}
+		}
+	}
+
+	patch := func(id int64, fields map[string]interface{}) error {
+		log.Debugf("Updating virtual chassis membership of device %d: %v", id, fields)
+
+		touched = append(touched, id)
+
+		if err := netboxDevicePatch(c, id, fields); err != nil {
+			log.Debugf("Failed to update device %d, rolling back virtual chassis %d: %v", id, virtualChassisID, err)
+
+			rollback()
+
+			return err
+		}
+
+		return nil
+	}
+
+	// Positions are unique within a virtual chassis, so members leaving or
+	// moving give up their position before any position is assigned.
+	for _, m := range current {
+		want, keep := desiredByID[m.DeviceID]
+
+		if keep && (m.VcPosition == nil || int64PtrEqual(want.VcPosition, m.VcPosition)) {
+			continue
+		}
+
+		fields := map[string]interface{}{"vc_position": nil}
+
+		if !keep {
+			fields["virtual_chassis"] = nil
+			fields["vc_priority"] = nil
+		}
+
+		if err := patch(m.DeviceID, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, m := range desired {
+		if have, ok := currentByID[m.DeviceID]; ok &&
+			int64PtrEqual(have.VcPosition, m.VcPosition) &&
+			int64PtrEqual(have.VcPriority, m.VcPriority) {
+			continue
+		}
+
+		fields := map[string]interface{}{
+			"virtual_chassis": virtualChassisID,
+			"vc_position":     m.VcPosition,
+			"vc_priority":     m.VcPriority,
+		}
+
+		if err := patch(m.DeviceID, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return rollback, nil
+}
+
+func resourceNetboxDcimVirtualChassisCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	masterID := int64(d.Get("master_id").(int))
+	members := expandVirtualChassisMembers(d)
+
+	if err := validateVirtualChassisMaster(masterID, members); err != nil {
+		return err
+	}
+
+	var parm = dcim.NewDcimVirtualChassisCreateParams().WithData(
+		&models.WritableVirtualChassis{
+			Master: &masterID,
+			Domain: d.Get("domain").(string),
+			Tags:   expandStringSet(d.Get("tags").(*schema.Set)),
+		},
+	)
+
+	log.Debugf("Executing DcimVirtualChassisCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimVirtualChassisCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimVirtualChassisCreate: %v", err)
+
+		return err
+	}
+
+	// Netbox adds the master to the new virtual chassis by itself, so the
+	// members are moved from what Netbox assigned rather than from none.
+	current, err := netboxVirtualChassisMembers(netboxClient, out.Payload.ID)
+
+	if err == nil {
+		_, err = applyVirtualChassisMembers(netboxClient, out.Payload.ID, current, members)
+	}
+
+	if err != nil {
+		log.Debugf("Failed to assign members, deleting virtual chassis %d: %v", out.Payload.ID, err)
+
+		var deleteParm = dcim.NewDcimVirtualChassisDeleteParams().WithID(out.Payload.ID)
+		if _, deleteErr := netboxClient.Dcim.DcimVirtualChassisDelete(deleteParm, nil); deleteErr != nil {
+			log.Errorf("Failed to delete virtual chassis %d: %v", out.Payload.ID, deleteErr)
+		}
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("dcim/virtual-chassis/%d", out.Payload.ID))
+	d.Set("virtual_chassis_id", out.Payload.ID)
+
+	log.Debugf("Done Executing DcimVirtualChassisCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualChassisRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("virtual_chassis_id").(int))
+
+	var parm = dcim.NewDcimVirtualChassisReadParams().WithID(id)
+
+	result, err := netboxClient.Dcim.DcimVirtualChassisRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Dcim VirtualChassis ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var masterID int64
+	if result.Payload.Master != nil {
+		masterID = result.Payload.Master.ID
+	}
+	d.Set("master_id", masterID)
+
+	d.Set("domain", result.Payload.Domain)
+	d.Set("tags", result.Payload.Tags)
+
+	members, err := netboxVirtualChassisMembers(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching members of Dcim VirtualChassis ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	memberList := make([]map[string]interface{}, 0, len(members))
+	for _, m := range members {
+		member := map[string]interface{}{
+			"device_id": m.DeviceID,
+		}
+
+		if m.VcPosition != nil {
+			member["vc_position"] = *m.VcPosition
+		}
+
+		if m.VcPriority != nil {
+			member["vc_priority"] = *m.VcPriority
+		}
+
+		memberList = append(memberList, member)
+	}
+	d.Set("member", memberList)
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualChassisUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("virtual_chassis_id").(int))
+
+	masterID := int64(d.Get("master_id").(int))
+	members := expandVirtualChassisMembers(d)
+
+	if err := validateVirtualChassisMaster(masterID, members); err != nil {
+		return err
+	}
+
+	current, err := netboxVirtualChassisMembers(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching members of Dcim VirtualChassis ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	// Members go first, as Netbox only accepts a master that already
+	// belongs to the virtual chassis.
+	rollback, err := applyVirtualChassisMembers(netboxClient, id, current, members)
+
+	if err != nil {
+		return err
+	}
+
+	var parm = dcim.NewDcimVirtualChassisUpdateParams().
+		WithID(id).
+		WithData(
+			&models.WritableVirtualChassis{
+				Master: &masterID,
+				Domain: d.Get("domain").(string),
+				Tags:   expandStringSet(d.Get("tags").(*schema.Set)),
+			},
+		)
+
+	log.Debugf("Executing DcimVirtualChassisUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Dcim.DcimVirtualChassisUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimVirtualChassisUpdate: %v", err)
+
+		rollback()
+
+		return err
+	}
+
+	log.Debugf("Done Executing DcimVirtualChassisUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxDcimVirtualChassisDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Dcim VirtualChassis: %v\n", d)
+
+	id := int64(d.Get("virtual_chassis_id").(int))
+
+	// Netbox releases the member devices itself when a virtual chassis is
+	// deleted.
+	var parm = dcim.NewDcimVirtualChassisDeleteParams().WithID(id)
+
+	out, err := netboxClient.Dcim.DcimVirtualChassisDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute DcimVirtualChassisDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing DcimVirtualChassisDelete: %v", out)
+
+	return nil
+}
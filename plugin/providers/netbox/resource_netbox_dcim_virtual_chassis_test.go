@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testInt64(i int64) *int64 {
+	return &i
+}
+
+func TestExpandVirtualChassisMembers(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxDcimVirtualChassis().Schema, map[string]interface{}{
+		"master_id": 1,
+		"member": []interface{}{
+			map[string]interface{}{"device_id": 1, "vc_position": 1, "vc_priority": 255},
+			map[string]interface{}{"device_id": 2, "vc_position": 0},
+		},
+	})
+
+	actual := make(map[int64]virtualChassisMember)
+	for _, m := range expandVirtualChassisMembers(d) {
+		actual[m.DeviceID] = m
+	}
+
+	// Position 0 is valid, while an unset priority is sent as null.
+	expected := map[int64]virtualChassisMember{
+		1: {DeviceID: 1, VcPosition: testInt64(1), VcPriority: testInt64(255)},
+		2: {DeviceID: 2, VcPosition: testInt64(0)},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestApplyVirtualChassisMembers(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  []virtualChassisMember
+		desired  []virtualChassisMember
+		failOn   string
+		expected []string
+		err      bool
+	}{
+		{
+			name: "new virtual chassis",
+			// Netbox adds the master without a position on create
+			current: []virtualChassisMember{
+				{DeviceID: 1},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1), VcPriority: testInt64(255)},
+				{DeviceID: 2, VcPosition: testInt64(2)},
+			},
+			expected: []string{
+				`1 {"vc_position":1,"vc_priority":255,"virtual_chassis":5}`,
+				`2 {"vc_position":2,"vc_priority":null,"virtual_chassis":5}`,
+			},
+		},
+		{
+			name: "unchanged",
+			current: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 2, VcPosition: testInt64(2)},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 2, VcPosition: testInt64(2)},
+			},
+			expected: []string{},
+		},
+		{
+			name: "swapped positions",
+			current: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 2, VcPosition: testInt64(2)},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(2)},
+				{DeviceID: 2, VcPosition: testInt64(1)},
+			},
+			expected: []string{
+				`1 {"vc_position":null}`,
+				`2 {"vc_position":null}`,
+				`1 {"vc_position":2,"vc_priority":null,"virtual_chassis":5}`,
+				`2 {"vc_position":1,"vc_priority":null,"virtual_chassis":5}`,
+			},
+		},
+		{
+			name: "removed member frees its position",
+			current: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 2, VcPosition: testInt64(2), VcPriority: testInt64(10)},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 3, VcPosition: testInt64(2)},
+			},
+			expected: []string{
+				`2 {"vc_position":null,"vc_priority":null,"virtual_chassis":null}`,
+				`3 {"vc_position":2,"vc_priority":null,"virtual_chassis":5}`,
+			},
+		},
+		{
+			name: "changed priority",
+			current: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1), VcPriority: testInt64(10)},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1), VcPriority: testInt64(20)},
+			},
+			expected: []string{
+				`1 {"vc_position":1,"vc_priority":20,"virtual_chassis":5}`,
+			},
+		},
+		{
+			name: "failure rolls back",
+			current: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(1)},
+				{DeviceID: 2, VcPosition: testInt64(2)},
+			},
+			desired: []virtualChassisMember{
+				{DeviceID: 1, VcPosition: testInt64(2)},
+				{DeviceID: 3, VcPosition: testInt64(1)},
+			},
+			failOn: `3 {"vc_position":1,"vc_priority":null,"virtual_chassis":5}`,
+			expected: []string{
+				`1 {"vc_position":null}`,
+				`2 {"vc_position":null,"vc_priority":null,"virtual_chassis":null}`,
+				`1 {"vc_position":2,"vc_priority":null,"virtual_chassis":5}`,
+				`3 {"vc_position":1,"vc_priority":null,"virtual_chassis":5}`,
+				// Rollback releases every touched position, then restores
+				// the original membership in reverse order.
+				`1 {"vc_position":null}`,
+				`2 {"vc_position":null}`,
+				`1 {"vc_position":null}`,
+				`3 {"vc_position":null}`,
+				`3 {"vc_position":null,"vc_priority":null,"virtual_chassis":null}`,
+				`1 {"vc_position":1,"vc_priority":null,"virtual_chassis":5}`,
+				`2 {"vc_position":2,"vc_priority":null,"virtual_chassis":5}`,
+				`1 {"vc_position":1,"vc_priority":null,"virtual_chassis":5}`,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := make([]string, 0)
+
+			meta := testNetboxClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "PATCH" || !strings.HasPrefix(r.URL.Path, "/api/dcim/devices/") {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatal(err)
+				}
+
+				encoded, _ := json.Marshal(body)
+				id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/dcim/devices/"), "/")
+				request := fmt.Sprintf("%s %s", id, encoded)
+
+				requests = append(requests, request)
+
+				if request == tc.failOn {
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"vc_position": ["A device in this virtual chassis already occupies this position."]}`)
+
+					return
+				}
+
+				fmt.Fprint(w, `{}`)
+			})
+
+			_, err := applyVirtualChassisMembers(meta.client, 5, tc.current, tc.desired)
+
+			if tc.err != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(requests, tc.expected) {
+				t.Fatalf("expected requests\n%s\ngot\n%s", strings.Join(tc.expected, "\n"), strings.Join(requests, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+)
+
+func resourceNetboxExtrasConfigContext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxExtrasConfigContextCreate,
+		Read:   resourceNetboxExtrasConfigContextRead,
+		Update: resourceNetboxExtrasConfigContextUpdate,
+		Delete: resourceNetboxExtrasConfigContextDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_context_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"weight": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(0, 32767),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_active": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"data": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"region_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"site_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"role_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"platform_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"cluster_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"cluster_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"tenant_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxExtrasConfigContextFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"weight":         d.Get("weight").(int),
+		"description":    d.Get("description").(string),
+		"is_active":      d.Get("is_active").(bool),
+		"data":           json.RawMessage(d.Get("data").(string)),
+		"regions":        expandInt64Set(d.Get("region_ids").(*schema.Set)),
+		"sites":          expandInt64Set(d.Get("site_ids").(*schema.Set)),
+		"roles":          expandInt64Set(d.Get("role_ids").(*schema.Set)),
+		"platforms":      expandInt64Set(d.Get("platform_ids").(*schema.Set)),
+		"cluster_groups": expandInt64Set(d.Get("cluster_group_ids").(*schema.Set)),
+		"clusters":       expandInt64Set(d.Get("cluster_ids").(*schema.Set)),
+		"tenant_groups":  expandInt64Set(d.Get("tenant_group_ids").(*schema.Set)),
+		"tenants":        expandInt64Set(d.Get("tenant_ids").(*schema.Set)),
+		"tags":           expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxExtrasConfigContextCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxExtrasConfigContextFields(d)
+
+	log.Debugf("Executing ExtrasConfigContextsCreate against Netbox: %v", fields)
+
+	var out configContextRecord
+
+	err := netboxRawWrite(netboxClient, "extras/config-contexts", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasConfigContextsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("extras/config-contexts/%d", out.ID))
+	d.Set("config_context_id", out.ID)
+
+	log.Debugf("Done Executing ExtrasConfigContextsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasConfigContextRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("config_context_id").(int))
+
+	result, err := netboxConfigContextRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Extras ConfigContext ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Name)
+	d.Set("weight", result.Weight)
+	d.Set("description", result.Description)
+	d.Set("is_active", result.IsActive)
+	d.Set("tags", result.Tags)
+
+	data, err := structure.NormalizeJsonString(string(result.Data))
+
+	if err != nil {
+		return err
+	}
+	d.Set("data", data)
+
+	d.Set("region_ids", flattenConfigContextAssignments(result.Regions))
+	d.Set("site_ids", flattenConfigContextAssignments(result.Sites))
+	d.Set("role_ids", flattenConfigContextAssignments(result.Roles))
+	d.Set("platform_ids", flattenConfigContextAssignments(result.Platforms))
+	d.Set("cluster_group_ids", flattenConfigContextAssignments(result.ClusterGroups))
+	d.Set("cluster_ids", flattenConfigContextAssignments(result.Clusters))
+	d.Set("tenant_group_ids", flattenConfigContextAssignments(result.TenantGroups))
+	d.Set("tenant_ids", flattenConfigContextAssignments(result.Tenants))
+
+	return nil
+}
+
+func resourceNetboxExtrasConfigContextUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("config_context_id").(int))
+
+	fields := resourceNetboxExtrasConfigContextFields(d)
+
+	log.Debugf("Executing ExtrasConfigContextsUpdate against Netbox: %v", fields)
+
+	var out configContextRecord
+
+	err := netboxRawWrite(netboxClient, "extras/config-contexts", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasConfigContextsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing ExtrasConfigContextsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasConfigContextDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Extras ConfigContext: %v\n", d)
+
+	id := int64(d.Get("config_context_id").(int))
+
+	var parm = extras.NewExtrasConfigContextsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Extras.ExtrasConfigContextsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasConfigContextsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing ExtrasConfigContextsDelete: %v", out)
+
+	return nil
+}
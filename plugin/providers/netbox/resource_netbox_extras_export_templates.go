@@ -0,0 +1,177 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxExtrasExportTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxExtrasExportTemplateCreate,
+		Read:   resourceNetboxExtrasExportTemplateRead,
+		Update: resourceNetboxExtrasExportTemplateUpdate,
+		Delete: resourceNetboxExtrasExportTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"export_template_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Content type of the exported objects, e.g. "dcim.device"
+			"content_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(exportTemplateContentTypes, false),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_language": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "jinja2",
+				ValidateFunc: validation.StringInSlice([]string{
+					"django",
+					"jinja2",
+				}, false),
+			},
+			"template_code": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mime_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"file_extension": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxExtrasExportTemplateFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"content_type":      d.Get("content_type").(string),
+		"name":              d.Get("name").(string),
+		"description":       d.Get("description").(string),
+		"template_language": d.Get("template_language").(string),
+		"template_code":     d.Get("template_code").(string),
+		"mime_type":         d.Get("mime_type").(string),
+		"file_extension":    d.Get("file_extension").(string),
+	}
+}
+
+func resourceNetboxExtrasExportTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxExtrasExportTemplateFields(d)
+
+	log.Debugf("Executing ExtrasExportTemplatesCreate against Netbox: %v", fields)
+
+	var out models.ExportTemplate
+
+	err := netboxRawWrite(netboxClient, "extras/export-templates", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasExportTemplatesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("extras/export-templates/%d", out.ID))
+	d.Set("export_template_id", out.ID)
+
+	log.Debugf("Done Executing ExtrasExportTemplatesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasExportTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("export_template_id").(int))
+
+	var parm = extras.NewExtrasExportTemplatesReadParams().WithID(id)
+
+	result, err := netboxClient.Extras.ExtrasExportTemplatesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Extras Export Template ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("content_type", result.Payload.ContentType)
+	d.Set("name", result.Payload.Name)
+	d.Set("description", result.Payload.Description)
+
+	var templateLanguage string
+	if result.Payload.TemplateLanguage != nil && result.Payload.TemplateLanguage.Value != nil {
+		templateLanguage = *result.Payload.TemplateLanguage.Value
+	}
+	d.Set("template_language", templateLanguage)
+
+	d.Set("template_code", result.Payload.TemplateCode)
+	d.Set("mime_type", result.Payload.MimeType)
+	d.Set("file_extension", result.Payload.FileExtension)
+
+	return nil
+}
+
+func resourceNetboxExtrasExportTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("export_template_id").(int))
+
+	fields := resourceNetboxExtrasExportTemplateFields(d)
+
+	log.Debugf("Executing ExtrasExportTemplatesUpdate against Netbox: %v", fields)
+
+	var out models.ExportTemplate
+
+	err := netboxRawWrite(netboxClient, "extras/export-templates", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasExportTemplatesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing ExtrasExportTemplatesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasExportTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Extras Export Template: %v\n", d)
+
+	id := int64(d.Get("export_template_id").(int))
+
+	var parm = extras.NewExtrasExportTemplatesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Extras.ExtrasExportTemplatesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasExportTemplatesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing ExtrasExportTemplatesDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// An unset description, MIME type or file extension must be sent empty so
+// that they are cleared in Netbox.
+func TestResourceNetboxExtrasExportTemplateFields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxExtrasExportTemplate().Schema, map[string]interface{}{
+		"content_type":  "dcim.device",
+		"name":          "DNS zone",
+		"template_code": "{{ queryset }}",
+	})
+
+	expected := map[string]interface{}{
+		"content_type":      "dcim.device",
+		"name":              "DNS zone",
+		"description":       "",
+		"template_language": "jinja2",
+		"template_code":     "{{ queryset }}",
+		"mime_type":         "",
+		"file_extension":    "",
+	}
+
+	if actual := resourceNetboxExtrasExportTemplateFields(d); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
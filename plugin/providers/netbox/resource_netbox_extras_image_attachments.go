@@ -0,0 +1,217 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+)
+
+func resourceNetboxExtrasImageAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNetboxExtrasImageAttachmentCreate,
+		Read:          resourceNetboxExtrasImageAttachmentRead,
+		Update:        resourceNetboxExtrasImageAttachmentUpdate,
+		Delete:        resourceNetboxExtrasImageAttachmentDelete,
+		CustomizeDiff: resourceNetboxExtrasImageAttachmentCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_attachment_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"dcim.site",
+					"dcim.rack",
+					"dcim.device",
+				}, false),
+			},
+			"object_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Path of the local image file to upload
+			"file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// SHA-256 hash of the uploaded file, used to detect changes of
+			// the local file
+			"file_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_width": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"image_height": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceNetboxExtrasImageAttachmentCustomizeDiff hashes the local file so
+// that a changed image is uploaded again even when its path stays the same.
+func resourceNetboxExtrasImageAttachmentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	file := d.Get("file").(string)
+
+	if file == "" {
+		return nil
+	}
+
+	hash, err := fileSHA256(file)
+
+	if err != nil {
+		return fmt.Errorf("Failed to read image file %s: %v", file, err)
+	}
+
+	if hash != d.Get("file_sha256").(string) {
+		d.SetNew("file_sha256", hash)
+		d.SetNewComputed("image_url")
+		d.SetNewComputed("image_width")
+		d.SetNewComputed("image_height")
+	}
+
+	return nil
+}
+
+func resourceNetboxExtrasImageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	file := d.Get("file").(string)
+
+	hash, err := fileSHA256(file)
+
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Executing ExtrasImageAttachmentsCreate against Netbox: %s", file)
+
+	out, err := netboxImageAttachmentWrite(
+		netboxClient,
+		0,
+		d.Get("content_type").(string),
+		int64(d.Get("object_id").(int)),
+		d.Get("name").(string),
+		file,
+	)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasImageAttachmentsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("extras/image-attachments/%d", out.ID))
+	d.Set("image_attachment_id", out.ID)
+	d.Set("file_sha256", hash)
+	d.Set("image_url", out.Image)
+	d.Set("image_width", out.ImageWidth)
+	d.Set("image_height", out.ImageHeight)
+
+	log.Debugf("Done Executing ExtrasImageAttachmentsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasImageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("image_attachment_id").(int))
+
+	result, err := netboxImageAttachmentRead(netboxClient, id)
+
+	if err != nil {
+		log.Debugf("Error fetching Extras Image Attachment ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("content_type", result.ContentType)
+	d.Set("object_id", result.ObjectID)
+	d.Set("name", result.Name)
+	d.Set("image_url", result.Image)
+	d.Set("image_width", result.ImageWidth)
+	d.Set("image_height", result.ImageHeight)
+
+	return nil
+}
+
+func resourceNetboxExtrasImageAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("image_attachment_id").(int))
+
+	file := d.Get("file").(string)
+
+	hash, err := fileSHA256(file)
+
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("Executing ExtrasImageAttachmentsUpdate against Netbox: %s", file)
+
+	out, err := netboxImageAttachmentWrite(
+		netboxClient,
+		id,
+		d.Get("content_type").(string),
+		int64(d.Get("object_id").(int)),
+		d.Get("name").(string),
+		file,
+	)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasImageAttachmentsUpdate: %v", err)
+
+		return err
+	}
+
+	d.Set("file_sha256", hash)
+	d.Set("image_url", out.Image)
+	d.Set("image_width", out.ImageWidth)
+	d.Set("image_height", out.ImageHeight)
+
+	log.Debugf("Done Executing ExtrasImageAttachmentsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasImageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Extras Image Attachment: %v\n", d)
+
+	id := int64(d.Get("image_attachment_id").(int))
+
+	var parm = extras.NewExtrasImageAttachmentsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Extras.ExtrasImageAttachmentsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasImageAttachmentsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing ExtrasImageAttachmentsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package netbox
+
+import (
+	"fmt"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxExtrasTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxExtrasTagCreate,
+		Read:   resourceNetboxExtrasTagRead,
+		Update: resourceNetboxExtrasTagUpdate,
+		Delete: resourceNetboxExtrasTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"color": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "9e9e9e",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-f]{6}$"), "must be a lowercase hex RGB value, e.g. \"00ff00\""),
+			},
+			// Netbox 2.7 has no tag description, so it is stored in the
+			// comments of the tag.
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tagged_items": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxExtrasTagFields(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":     d.Get("name").(string),
+		"slug":     d.Get("slug").(string),
+		"color":    d.Get("color").(string),
+		"comments": d.Get("description").(string),
+	}
+}
+
+func resourceNetboxExtrasTagCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxExtrasTagFields(d)
+
+	log.Debugf("Executing ExtrasTagsCreate against Netbox: %v", fields)
+
+	var out models.Tag
+
+	err := netboxRawWrite(netboxClient, "extras/tags", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasTagsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("extras/tags/%d", out.ID))
+	d.Set("tag_id", out.ID)
+
+	log.Debugf("Done Executing ExtrasTagsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasTagRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tag_id").(int))
+
+	var parm = extras.NewExtrasTagsReadParams().WithID(id)
+
+	result, err := netboxClient.Extras.ExtrasTagsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Extras Tag ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+	d.Set("color", result.Payload.Color)
+	d.Set("description", result.Payload.Comments)
+	d.Set("tagged_items", result.Payload.TaggedItems)
+
+	return nil
+}
+
+func resourceNetboxExtrasTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tag_id").(int))
+
+	fields := resourceNetboxExtrasTagFields(d)
+
+	log.Debugf("Executing ExtrasTagsUpdate against Netbox: %v", fields)
+
+	var out models.Tag
+
+	err := netboxRawWrite(netboxClient, "extras/tags", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasTagsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing ExtrasTagsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxExtrasTagDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Extras Tag: %v\n", d)
+
+	id := int64(d.Get("tag_id").(int))
+
+	var parm = extras.NewExtrasTagsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Extras.ExtrasTagsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute ExtrasTagsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing ExtrasTagsDelete: %v", out)
+
+	return nil
+}
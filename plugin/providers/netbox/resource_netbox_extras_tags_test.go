@@ -0,0 +1,28 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// An unset description must be sent as empty comments so that it is cleared
+// in Netbox.
+func TestResourceNetboxExtrasTagFields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxExtrasTag().Schema, map[string]interface{}{
+		"name": "Core",
+		"slug": "core",
+	})
+
+	expected := map[string]interface{}{
+		"name":     "Core",
+		"slug":     "core",
+		"color":    "9e9e9e",
+		"comments": "",
+	}
+
+	if actual := resourceNetboxExtrasTagFields(d); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
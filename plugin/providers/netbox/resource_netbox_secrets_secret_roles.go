@@ -0,0 +1,147 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/secrets"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxSecretsSecretRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSecretsSecretRoleCreate,
+		Read:   resourceNetboxSecretsSecretRoleRead,
+		Update: resourceNetboxSecretsSecretRoleUpdate,
+		Delete: resourceNetboxSecretsSecretRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_role_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxSecretsSecretRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = secrets.NewSecretsSecretRolesCreateParams().WithData(
+		&models.SecretRole{
+			Name:        &name,
+			Slug:        &slug,
+			Description: d.Get("description").(string),
+		},
+	)
+
+	log.Debugf("Executing SecretsSecretRolesCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Secrets.SecretsSecretRolesCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretRolesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("secrets/secret-roles/%d", out.Payload.ID))
+	d.Set("secret_role_id", out.Payload.ID)
+
+	log.Debugf("Done Executing SecretsSecretRolesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("secret_role_id").(int))
+
+	var parm = secrets.NewSecretsSecretRolesReadParams().WithID(id)
+
+	result, err := netboxClient.Secrets.SecretsSecretRolesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Secrets Secret Role ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+	d.Set("description", result.Payload.Description)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("secret_role_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = secrets.NewSecretsSecretRolesUpdateParams().
+		WithID(id).
+		WithData(
+			&models.SecretRole{
+				Name:        &name,
+				Slug:        &slug,
+				Description: d.Get("description").(string),
+			},
+		)
+
+	log.Debugf("Executing SecretsSecretRolesUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Secrets.SecretsSecretRolesUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretRolesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing SecretsSecretRolesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Secrets Secret Role: %v\n", d)
+
+	id := int64(d.Get("secret_role_id").(int))
+
+	var parm = secrets.NewSecretsSecretRolesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Secrets.SecretsSecretRolesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretRolesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing SecretsSecretRolesDelete: %v", out)
+
+	return nil
+}
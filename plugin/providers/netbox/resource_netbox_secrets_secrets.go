@@ -0,0 +1,182 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/secrets"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxSecretsSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxSecretsSecretCreate,
+		Read:   resourceNetboxSecretsSecretRead,
+		Update: resourceNetboxSecretsSecretUpdate,
+		Delete: resourceNetboxSecretsSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"plaintext": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxSecretsSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	deviceID := int64(d.Get("device_id").(int))
+	roleID := int64(d.Get("role_id").(int))
+	plaintext := d.Get("plaintext").(string)
+
+	var parm = secrets.NewSecretsSecretsCreateParams().WithData(
+		&models.WritableSecret{
+			Device:    &deviceID,
+			Role:      &roleID,
+			Name:      d.Get("name").(string),
+			Plaintext: &plaintext,
+			Tags:      expandStringSet(d.Get("tags").(*schema.Set)),
+		},
+	)
+
+	// The parameters hold the plaintext, so only the target is logged
+	log.Debugf("Executing SecretsSecretsCreate against Netbox for device %d", deviceID)
+
+	out, err := netboxClient.Secrets.SecretsSecretsCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("secrets/secrets/%d", out.Payload.ID))
+	d.Set("secret_id", out.Payload.ID)
+
+	log.Debugf("Done Executing SecretsSecretsCreate: %d", out.Payload.ID)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("secret_id").(int))
+
+	var parm = secrets.NewSecretsSecretsReadParams().WithID(id)
+
+	result, err := netboxClient.Secrets.SecretsSecretsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Secrets Secret ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	var deviceID int64
+	if result.Payload.Device != nil {
+		deviceID = result.Payload.Device.ID
+	}
+	d.Set("device_id", deviceID)
+
+	var roleID int64
+	if result.Payload.Role != nil {
+		roleID = result.Payload.Role.ID
+	}
+	d.Set("role_id", roleID)
+
+	d.Set("name", result.Payload.Name)
+
+	// Netbox only decrypts the secret when a session key was sent along
+	if result.Payload.Plaintext != nil {
+		d.Set("plaintext", result.Payload.Plaintext)
+	}
+
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("secret_id").(int))
+
+	deviceID := int64(d.Get("device_id").(int))
+	roleID := int64(d.Get("role_id").(int))
+	plaintext := d.Get("plaintext").(string)
+
+	var parm = secrets.NewSecretsSecretsUpdateParams().
+		WithID(id).
+		WithData(
+			&models.WritableSecret{
+				Device:    &deviceID,
+				Role:      &roleID,
+				Name:      d.Get("name").(string),
+				Plaintext: &plaintext,
+				Tags:      expandStringSet(d.Get("tags").(*schema.Set)),
+			},
+		)
+
+	log.Debugf("Executing SecretsSecretsUpdate against Netbox: %d", id)
+
+	out, err := netboxClient.Secrets.SecretsSecretsUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing SecretsSecretsUpdate: %d", out.Payload.ID)
+
+	return nil
+}
+
+func resourceNetboxSecretsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("secret_id").(int))
+
+	log.Debugf("Deleting Secrets Secret: %d\n", id)
+
+	var parm = secrets.NewSecretsSecretsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Secrets.SecretsSecretsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute SecretsSecretsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing SecretsSecretsDelete: %v", out)
+
+	return nil
+}
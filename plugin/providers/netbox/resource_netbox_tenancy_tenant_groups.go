@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxTenancyTenantGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTenancyTenantGroupCreate,
+		Read:   resourceNetboxTenancyTenantGroupRead,
+		Update: resourceNetboxTenancyTenantGroupUpdate,
+		Delete: resourceNetboxTenancyTenantGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_group_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxTenancyTenantGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = tenancy.NewTenancyTenantGroupsCreateParams().WithData(
+		&models.TenantGroup{
+			Name: &name,
+			Slug: &slug,
+		},
+	)
+
+	log.Debugf("Executing TenancyTenantGroupsCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Tenancy.TenancyTenantGroupsCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantGroupsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("tenancy/tenant-groups/%d", out.Payload.ID))
+	d.Set("tenant_group_id", out.Payload.ID)
+
+	log.Debugf("Done Executing TenancyTenantGroupsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantGroupRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tenant_group_id").(int))
+
+	var parm = tenancy.NewTenancyTenantGroupsReadParams().WithID(id)
+
+	result, err := netboxClient.Tenancy.TenancyTenantGroupsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Tenancy TenantGroup ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tenant_group_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = tenancy.NewTenancyTenantGroupsUpdateParams().
+		WithID(id).
+		WithData(
+			&models.TenantGroup{
+				Name: &name,
+				Slug: &slug,
+			},
+		)
+
+	log.Debugf("Executing TenancyTenantGroupsUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Tenancy.TenancyTenantGroupsUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantGroupsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing TenancyTenantGroupsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Tenancy TenantGroup: %v\n", d)
+
+	id := int64(d.Get("tenant_group_id").(int))
+
+	var parm = tenancy.NewTenancyTenantGroupsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Tenancy.TenancyTenantGroupsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantGroupsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing TenancyTenantGroupsDelete: %v", out)
+
+	return nil
+}
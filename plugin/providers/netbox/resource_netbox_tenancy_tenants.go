@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxTenancyTenant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxTenancyTenantCreate,
+		Read:   resourceNetboxTenancyTenantRead,
+		Update: resourceNetboxTenancyTenantUpdate,
+		Delete: resourceNetboxTenancyTenantDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comments": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetboxTenancyTenantFields(d *schema.ResourceData) map[string]interface{} {
+	groupID := int64(d.Get("group_id").(int))
+
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"group":       nilFromInt64Ptr(&groupID),
+		"description": d.Get("description").(string),
+		"comments":    d.Get("comments").(string),
+		"tags":        expandStringSet(d.Get("tags").(*schema.Set)),
+	}
+}
+
+func resourceNetboxTenancyTenantCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxTenancyTenantFields(d)
+
+	log.Debugf("Executing TenancyTenantsCreate against Netbox: %v", fields)
+
+	var out models.Tenant
+
+	err := netboxRawWrite(netboxClient, "tenancy/tenants", 0, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("tenancy/tenants/%d", out.ID))
+	d.Set("tenant_id", out.ID)
+
+	log.Debugf("Done Executing TenancyTenantsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tenant_id").(int))
+
+	var parm = tenancy.NewTenancyTenantsReadParams().WithID(id)
+
+	result, err := netboxClient.Tenancy.TenancyTenantsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Tenancy Tenant ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	var groupID int64
+	if result.Payload.Group != nil {
+		groupID = result.Payload.Group.ID
+	}
+	d.Set("group_id", groupID)
+
+	d.Set("description", result.Payload.Description)
+	d.Set("comments", result.Payload.Comments)
+	d.Set("tags", result.Payload.Tags)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("tenant_id").(int))
+
+	fields := resourceNetboxTenancyTenantFields(d)
+
+	log.Debugf("Executing TenancyTenantsUpdate against Netbox: %v", fields)
+
+	var out models.Tenant
+
+	err := netboxRawWrite(netboxClient, "tenancy/tenants", id, fields, &out)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing TenancyTenantsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxTenancyTenantDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Tenancy Tenant: %v\n", d)
+
+	id := int64(d.Get("tenant_id").(int))
+
+	var parm = tenancy.NewTenancyTenantsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Tenancy.TenancyTenantsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute TenancyTenantsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing TenancyTenantsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// An unset group, description or comments must be sent empty so that they are
+// cleared in Netbox.
+func TestResourceNetboxTenancyTenantFields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNetboxTenancyTenant().Schema, map[string]interface{}{
+		"name": "Tenant A",
+		"slug": "tenant-a",
+	})
+
+	expected := map[string]interface{}{
+		"name":        "Tenant A",
+		"slug":        "tenant-a",
+		"group":       (*int64)(nil),
+		"description": "",
+		"comments":    "",
+		"tags":        []string{},
+	}
+
+	if actual := resourceNetboxTenancyTenantFields(d); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxVirtualizationClusterGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualizationClusterGroupCreate,
+		Read:   resourceNetboxVirtualizationClusterGroupRead,
+		Update: resourceNetboxVirtualizationClusterGroupUpdate,
+		Delete: resourceNetboxVirtualizationClusterGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_group_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxVirtualizationClusterGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = virtualization.NewVirtualizationClusterGroupsCreateParams().WithData(
+		&models.ClusterGroup{
+			Name: &name,
+			Slug: &slug,
+		},
+	)
+
+	log.Debugf("Executing VirtualizationClusterGroupsCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterGroupsCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterGroupsCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("virtualization/cluster-groups/%d", out.Payload.ID))
+	d.Set("cluster_group_id", out.Payload.ID)
+
+	log.Debugf("Done Executing VirtualizationClusterGroupsCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterGroupRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cluster_group_id").(int))
+
+	var parm = virtualization.NewVirtualizationClusterGroupsReadParams().WithID(id)
+
+	result, err := netboxClient.Virtualization.VirtualizationClusterGroupsRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Virtualization Cluster Group ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cluster_group_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = virtualization.NewVirtualizationClusterGroupsUpdateParams().
+		WithID(id).
+		WithData(
+			&models.ClusterGroup{
+				Name: &name,
+				Slug: &slug,
+			},
+		)
+
+	log.Debugf("Executing VirtualizationClusterGroupsUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterGroupsUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterGroupsUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing VirtualizationClusterGroupsUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Virtualization Cluster Group: %v\n", d)
+
+	id := int64(d.Get("cluster_group_id").(int))
+
+	var parm = virtualization.NewVirtualizationClusterGroupsDeleteParams().WithID(id)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterGroupsDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterGroupsDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing VirtualizationClusterGroupsDelete: %v", out)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package netbox
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func resourceNetboxVirtualizationClusterType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetboxVirtualizationClusterTypeCreate,
+		Read:   resourceNetboxVirtualizationClusterTypeRead,
+		Update: resourceNetboxVirtualizationClusterTypeUpdate,
+		Delete: resourceNetboxVirtualizationClusterTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_type_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"slug": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetboxVirtualizationClusterTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = virtualization.NewVirtualizationClusterTypesCreateParams().WithData(
+		&models.ClusterType{
+			Name: &name,
+			Slug: &slug,
+		},
+	)
+
+	log.Debugf("Executing VirtualizationClusterTypesCreate against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterTypesCreate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterTypesCreate: %v", err)
+
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("virtualization/cluster-types/%d", out.Payload.ID))
+	d.Set("cluster_type_id", out.Payload.ID)
+
+	log.Debugf("Done Executing VirtualizationClusterTypesCreate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterTypeRead(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cluster_type_id").(int))
+
+	var parm = virtualization.NewVirtualizationClusterTypesReadParams().WithID(id)
+
+	result, err := netboxClient.Virtualization.VirtualizationClusterTypesRead(parm, nil)
+
+	if err != nil {
+		log.Debugf("Error fetching Virtualization Cluster Type ID # %d from Netbox = %v", id, err)
+		return err
+	}
+
+	d.Set("name", result.Payload.Name)
+	d.Set("slug", result.Payload.Slug)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	id := int64(d.Get("cluster_type_id").(int))
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	var parm = virtualization.NewVirtualizationClusterTypesUpdateParams().
+		WithID(id).
+		WithData(
+			&models.ClusterType{
+				Name: &name,
+				Slug: &slug,
+			},
+		)
+
+	log.Debugf("Executing VirtualizationClusterTypesUpdate against Netbox: %v", parm)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterTypesUpdate(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterTypesUpdate: %v", err)
+
+		return err
+	}
+
+	log.Debugf("Done Executing VirtualizationClusterTypesUpdate: %v", out)
+
+	return nil
+}
+
+func resourceNetboxVirtualizationClusterTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+	log.Debugf("Deleting Virtualization Cluster Type: %v\n", d)
+
+	id := int64(d.Get("cluster_type_id").(int))
+
+	var parm = virtualization.NewVirtualizationClusterTypesDeleteParams().WithID(id)
+
+	out, err := netboxClient.Virtualization.VirtualizationClusterTypesDelete(parm, nil)
+
+	if err != nil {
+		log.Debugf("Failed to execute VirtualizationClusterTypesDelete: %v", err)
+	}
+
+	log.Debugf("Done Executing VirtualizationClusterTypesDelete: %v", out)
+
+	return nil
+}
@@ -1,15 +1,16 @@
 package netbox
 
 import (
+	"encoding/json"
 	"fmt"
 	//"strconv"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
-	"github.com/netbox-community/go-netbox/netbox/models"
 )
 
 func resourceNetboxVirtualizationVirtualMachine() *schema.Resource {
@@ -55,10 +56,22 @@ func resourceNetboxVirtualizationVirtualMachine() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"site_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"platform_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"primary_ip4_id": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"primary_ip6_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 			"role_id": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -79,41 +92,85 @@ func resourceNetboxVirtualizationVirtualMachine() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"local_context_data": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"config_context": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Values of the custom fields managed by Terraform, by field name.
+			// Custom fields not listed here are left untouched.
+			"custom_fields": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
-func resourceNetboxVirtualizationVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
-	netboxClient := meta.(*ProviderNetboxClient).client
-
+// resourceNetboxVirtualizationVirtualMachineFields builds the request body for
+// a virtual machine. Unset optional fields are sent as null so that removing
+// them from the configuration also clears them in Netbox.
+func resourceNetboxVirtualizationVirtualMachineFields(d *schema.ResourceData) map[string]interface{} {
 	clusterID := int64(d.Get("cluster_id").(int))
 	diskGB := int64(d.Get("disk_gb").(int))
 	memoryMB := int64(d.Get("memory_mb").(int))
 	vcpus := int64(d.Get("vcpus").(int))
 	name := d.Get("name").(string)
+	platformID := int64(d.Get("platform_id").(int))
 	primaryIp4ID := int64(d.Get("primary_ip4_id").(int))
+	primaryIp6ID := int64(d.Get("primary_ip6_id").(int))
 	roleID := int64(d.Get("role_id").(int))
 	tenantID := int64(d.Get("tenant_id").(int))
 
-	var parm = virtualization.NewVirtualizationVirtualMachinesCreateParams().WithData(
-		&models.WritableVirtualMachineWithConfigContext{
-			Cluster:    &clusterID,
-			Comments:   d.Get("comments").(string),
-			Disk:       nilFromInt64Ptr(&diskGB),
-			Memory:     nilFromInt64Ptr(&memoryMB),
-			Vcpus:      nilFromInt64Ptr(&vcpus),
-			Name:       &name,
-			PrimaryIp4: nilFromInt64Ptr(&primaryIp4ID),
-			Role:       nilFromInt64Ptr(&roleID),
-			Status:     d.Get("status").(string),
-			Tenant:     nilFromInt64Ptr(&tenantID),
-			Tags:       []string{},
-		},
-	)
+	fields := map[string]interface{}{
+		"cluster":            &clusterID,
+		"comments":           d.Get("comments").(string),
+		"disk":               nilFromInt64Ptr(&diskGB),
+		"memory":             nilFromInt64Ptr(&memoryMB),
+		"vcpus":              nilFromInt64Ptr(&vcpus),
+		"name":               &name,
+		"platform":           nilFromInt64Ptr(&platformID),
+		"primary_ip4":        nilFromInt64Ptr(&primaryIp4ID),
+		"primary_ip6":        nilFromInt64Ptr(&primaryIp6ID),
+		"role":               nilFromInt64Ptr(&roleID),
+		"tenant":             nilFromInt64Ptr(&tenantID),
+		"local_context_data": nil,
+		"tags":               expandStringSet(d.Get("tags").(*schema.Set)),
+		"custom_fields":      expandCustomFields(d),
+	}
+
+	if status := d.Get("status").(string); status != "" {
+		fields["status"] = status
+	}
+
+	if localContextData := d.Get("local_context_data").(string); localContextData != "" {
+		fields["local_context_data"] = json.RawMessage(localContextData)
+	}
+
+	return fields
+}
+
+func resourceNetboxVirtualizationVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	netboxClient := meta.(*ProviderNetboxClient).client
+
+	fields := resourceNetboxVirtualizationVirtualMachineFields(d)
+
+	log.Debugf("Executing VirtualizationVirtualMachinesCreate againts Netbox: %v", fields)
 
-	log.Debugf("Executing VirtualizationVirtualMachinesCreate againts Netbox: %v", parm)
+	var out virtualMachineRecord
 
-	out, err := netboxClient.Virtualization.VirtualizationVirtualMachinesCreate(parm, nil)
+	err := netboxRawWrite(netboxClient, "virtualization/virtual-machines", 0, fields, &out)
 
 	if err != nil {
 		log.Debugf("Failed to execute VirtualizationVirtualMachinesCreate: %v", err)
@@ -121,8 +178,8 @@ func resourceNetboxVirtualizationVirtualMachineCreate(d *schema.ResourceData, me
 		return err
 	}
 
-	d.SetId(fmt.Sprintf("virtualization/virtual-machines/%d", out.Payload.ID))
-	d.Set("virtual_machine_id", out.Payload.ID)
+	d.SetId(fmt.Sprintf("virtualization/virtual-machines/%d", out.ID))
+	d.Set("virtual_machine_id", out.ID)
 
 	log.Debugf("Done Executing VirtualizationVirtualMachinesCreate: %v", out)
 
@@ -135,9 +192,7 @@ func resourceNetboxVirtualizationVirtualMachineRead(d *schema.ResourceData, meta
 	id := int64(d.Get("virtual_machine_id").(int))
 	//id_string := strconv.FormatInt(id, 10)
 
-	var parm = virtualization.NewVirtualizationVirtualMachinesReadParams().WithID(id)
-
-	result, err := netboxClient.Virtualization.VirtualizationVirtualMachinesRead(parm, nil)
+	result, err := netboxVirtualMachineRead(netboxClient, id)
 
 	if err != nil {
 		log.Debugf("Error fetching Virtualization VirtualMachine ID # %d from Netbox = %v", id, err)
@@ -145,39 +200,77 @@ func resourceNetboxVirtualizationVirtualMachineRead(d *schema.ResourceData, meta
 	}
 
 	var clusterID int64
-	if result.Payload.Cluster != nil {
-		clusterID = result.Payload.Cluster.ID
+	if result.Cluster != nil {
+		clusterID = result.Cluster.ID
 	}
 	d.Set("cluster_id", clusterID)
 
-	d.Set("comments", result.Payload.Comments)
+	d.Set("comments", result.Comments)
 
-	d.Set("disk_gb", result.Payload.Disk)
-	d.Set("memory_mb", result.Payload.Memory)
-	d.Set("vcpus", result.Payload.Vcpus)
-	d.Set("name", result.Payload.Name)
-	d.Set("site", result.Payload.Site)
+	d.Set("disk_gb", result.Disk)
+	d.Set("memory_mb", result.Memory)
+	d.Set("vcpus", result.Vcpus)
+	d.Set("name", result.Name)
 
-	var primaryIp4ID int64
-	if result.Payload.PrimaryIp4 != nil {
-		primaryIp4ID = result.Payload.PrimaryIp4.ID
+	var site string
+	if result.Site != nil {
+		site = result.Site.Name
 	}
-	d.Set("primary_ip4_id", primaryIp4ID)
+	d.Set("site", site)
 
 	var siteID int64
-	if result.Payload.Role != nil {
-		siteID = result.Payload.Role.ID
+	if result.Site != nil {
+		siteID = result.Site.ID
 	}
 	d.Set("site_id", siteID)
 
-	d.Set("status", result.Payload.Status)
+	var platformID int64
+	if result.Platform != nil {
+		platformID = result.Platform.ID
+	}
+	d.Set("platform_id", platformID)
+
+	var primaryIp4ID int64
+	if result.PrimaryIp4 != nil {
+		primaryIp4ID = result.PrimaryIp4.ID
+	}
+	d.Set("primary_ip4_id", primaryIp4ID)
+
+	var primaryIp6ID int64
+	if result.PrimaryIp6 != nil {
+		primaryIp6ID = result.PrimaryIp6.ID
+	}
+	d.Set("primary_ip6_id", primaryIp6ID)
+
+	var roleID int64
+	if result.Role != nil {
+		roleID = result.Role.ID
+	}
+	d.Set("role_id", roleID)
 
 	var tenantID int64
-	if result.Payload.Tenant != nil {
-		tenantID = result.Payload.Tenant.ID
+	if result.Tenant != nil {
+		tenantID = result.Tenant.ID
 	}
 	d.Set("tenant_id", tenantID)
 
+	localContextData, err := flattenJSON(result.LocalContextData)
+
+	if err != nil {
+		return err
+	}
+	d.Set("local_context_data", localContextData)
+
+	configContext, err := flattenJSON(result.ConfigContext)
+
+	if err != nil {
+		return err
+	}
+	d.Set("config_context", configContext)
+
+	d.Set("tags", result.Tags)
+	d.Set("custom_fields", flattenManagedCustomFields(d, result.CustomFields))
+
 	return nil
 }
 
@@ -186,36 +279,13 @@ func resourceNetboxVirtualizationVirtualMachineUpdate(d *schema.ResourceData, me
 
 	id := int64(d.Get("virtual_machine_id").(int))
 
-	clusterID := int64(d.Get("cluster_id").(int))
-	diskGB := int64(d.Get("disk_gb").(int))
-	memoryMB := int64(d.Get("memory_mb").(int))
-	vcpus := int64(d.Get("vcpus").(int))
-	name := d.Get("name").(string)
-	primaryIp4ID := int64(d.Get("primary_ip4_id").(int))
-	roleID := int64(d.Get("role_id").(int))
-	tenantID := int64(d.Get("tenant_id").(int))
+	fields := resourceNetboxVirtualizationVirtualMachineFields(d)
+
+	log.Debugf("Executing VirtualizationVirtualMachinesUpdate againts Netbox: %v", fields)
+
+	var out virtualMachineRecord
 
-	var parm = virtualization.NewVirtualizationVirtualMachinesUpdateParams().
-		WithID(id).
-		WithData(
-			&models.WritableVirtualMachineWithConfigContext{
-				Cluster:    &clusterID,
-				Comments:   d.Get("comments").(string),
-				Disk:       nilFromInt64Ptr(&diskGB),
-				Memory:     nilFromInt64Ptr(&memoryMB),
-				Vcpus:      nilFromInt64Ptr(&vcpus),
-				Name:       &name,
-				PrimaryIp4: nilFromInt64Ptr(&primaryIp4ID),
-				Role:       nilFromInt64Ptr(&roleID),
-				Status:     d.Get("status").(string),
-				Tenant:     nilFromInt64Ptr(&tenantID),
-				Tags:       []string{},
-			},
-		)
-
-	log.Debugf("Executing VirtualizationVirtualMachinesUpdate againts Netbox: %v", parm)
-
-	out, err := netboxClient.Virtualization.VirtualizationVirtualMachinesUpdate(parm, nil)
+	err := netboxRawWrite(netboxClient, "virtualization/virtual-machines", id, fields, &out)
 
 	if err != nil {
 		log.Debugf("Failed to execute VirtualizationVirtualMachinesUpdate: %v", err)
@@ -0,0 +1,31 @@
+package netbox
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// netboxSessionKey exchanges the private key of the user for a session key.
+// go-netbox does not send a request body for this endpoint, so it is called
+// directly. An existing session key of the user is preserved, so that
+// several Terraform runs can work with secrets at the same time.
+func netboxSessionKey(c *client.NetBox, privateKey string) (string, error) {
+	var out struct {
+		SessionKey string `json:"session_key"`
+	}
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetBodyParam(map[string]interface{}{
+			"private_key":  privateKey,
+			"preserve_key": true,
+		})
+	}
+
+	err := netboxRawOperation(c, "secrets_get-session-key_create", "POST", "/secrets/get-session-key/", params, &out)
+	if err != nil {
+		return "", err
+	}
+
+	return out.SessionKey, nil
+}
@@ -1,5 +1,12 @@
 package netbox
 
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+)
+
 // we need to convert some int64 pointers to nil in case Terraform SDK passed
 // value is 0, this due to https://github.com/hashicorp/terraform-plugin-sdk/issues/90
 func nilFromInt64Ptr(i *int64) *int64 {
@@ -9,3 +16,45 @@ func nilFromInt64Ptr(i *int64) *int64 {
 
 	return i
 }
+
+// expandStringSet converts a Terraform set of strings, such as tags, into the
+// string slice expected by the Netbox models.
+func expandStringSet(s *schema.Set) []string {
+	out := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+
+	return out
+}
+
+// expandInt64Set converts a Terraform set of integers, such as VLAN IDs, into
+// the int64 slice expected by the Netbox models.
+func expandInt64Set(s *schema.Set) []int64 {
+	out := make([]int64, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, int64(v.(int)))
+	}
+
+	return out
+}
+
+// nilFromStringPtr returns nil for empty strings so that optional nullable
+// fields are omitted from the request instead of being sent as "".
+func nilFromStringPtr(s *string) *string {
+	if *s == "" {
+		return nil
+	}
+
+	return s
+}
+
+// flattenJSON normalizes a raw JSON value from the Netbox API for storing in
+// a string attribute, returning "" for a null or missing value.
+func flattenJSON(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	return structure.NormalizeJsonString(string(raw))
+}
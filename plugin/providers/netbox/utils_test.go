@@ -0,0 +1,44 @@
+package netbox
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlattenJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      json.RawMessage
+		expected string
+		wantErr  bool
+	}{
+		{"empty", nil, "", false},
+		{"null", json.RawMessage(`null`), "", false},
+		{"object", json.RawMessage(`{"ntp": ["10.0.0.1"], "dns": "10.0.0.53"}`), `{"dns":"10.0.0.53","ntp":["10.0.0.1"]}`, false},
+		{"nested", json.RawMessage(`{ "a": { "c": 1, "b": true } }`), `{"a":{"b":true,"c":1}}`, false},
+		{"empty object", json.RawMessage(`{}`), `{}`, false},
+		{"invalid", json.RawMessage(`{"ntp":`), "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := flattenJSON(tc.raw)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", actual)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			if actual != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package netbox
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+// virtualMachineRecord is a virtual machine as returned by the Netbox API.
+// go-netbox decodes the rendered config context into a string map and the
+// local context data into a string, both of which fail for structured data,
+// so virtual machines are read and written directly instead.
+type virtualMachineRecord struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Status *struct {
+		Value string `json:"value"`
+	} `json:"status"`
+	Site *struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"site"`
+	Cluster *struct {
+		ID int64 `json:"id"`
+	} `json:"cluster"`
+	Role *struct {
+		ID int64 `json:"id"`
+	} `json:"role"`
+	Tenant *struct {
+		ID int64 `json:"id"`
+	} `json:"tenant"`
+	Platform *struct {
+		ID int64 `json:"id"`
+	} `json:"platform"`
+	PrimaryIp4 *struct {
+		ID      int64  `json:"id"`
+		Address string `json:"address"`
+	} `json:"primary_ip4"`
+	PrimaryIp6 *struct {
+		ID      int64  `json:"id"`
+		Address string `json:"address"`
+	} `json:"primary_ip6"`
+	Vcpus            *int64                 `json:"vcpus"`
+	Memory           *int64                 `json:"memory"`
+	Disk             *int64                 `json:"disk"`
+	Comments         string                 `json:"comments"`
+	Tags             []string               `json:"tags"`
+	CustomFields     map[string]interface{} `json:"custom_fields"`
+	LocalContextData json.RawMessage        `json:"local_context_data"`
+	ConfigContext    json.RawMessage        `json:"config_context"`
+}
+
+// netboxVirtualMachineRead reads a single virtual machine by ID.
+func netboxVirtualMachineRead(c *client.NetBox, id int64) (*virtualMachineRecord, error) {
+	var vm virtualMachineRecord
+
+	params := func(r runtime.ClientRequest, reg strfmt.Registry) error {
+		return r.SetPathParam("id", strconv.FormatInt(id, 10))
+	}
+
+	err := netboxRawOperation(c, "virtualization_virtual-machines_read", "GET", "/virtualization/virtual-machines/{id}/", params, &vm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vm, nil
+}
+
+// netboxVirtualMachineList lists the virtual machines matching the given
+// filters.
+func netboxVirtualMachineList(c *client.NetBox, params *virtualization.VirtualizationVirtualMachinesListParams) ([]virtualMachineRecord, error) {
+	vms := make([]virtualMachineRecord, 0)
+
+	for {
+		offset := int64(len(vms))
+		params.SetOffset(&offset)
+
+		var page struct {
+			Count   int64                  `json:"count"`
+			Results []virtualMachineRecord `json:"results"`
+		}
+
+		err := netboxRawOperation(c, "virtualization_virtual-machines_list", "GET", "/virtualization/virtual-machines/", params.WriteToRequest, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		vms = append(vms, page.Results...)
+
+		if len(page.Results) == 0 || int64(len(vms)) >= page.Count {
+			break
+		}
+	}
+
+	return vms, nil
+}